@@ -17,29 +17,65 @@ limitations under the License.
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
 
 	log "github.com/golang/glog"
 	"github.com/youtube/vitess/go/exit"
 	"github.com/youtube/vitess/go/vt/logutil"
 	"github.com/youtube/vitess/go/vt/servenv"
 	"github.com/youtube/vitess/go/vt/vtexplain"
+
+	querypb "github.com/youtube/vitess/go/vt/proto/query"
 )
 
 var (
 	sqlFlag         = flag.String("sql", "", "A list of semicolon-delimited SQL commands to analyze")
 	sqlFileFlag     = flag.String("sql-file", "", "Identifies the file that contains the SQL commands to analyze")
-	schemaFlag      = flag.String("schema", "", "The SQL table schema")
+	schemaFlag      = flag.String("schema", "", "The SQL table schema. Tables qualified by a keyspace, e.g. ks.t1, only exist in that keyspace")
 	schemaFileFlag  = flag.String("schema-file", "", "Identifies the file that contains the SQL table schema")
+	schemaTablet    = flag.String("schema-from-tablet", "", "Loads the SQL table schema from the vttablet at the given grpc address instead of schema or schema-file")
+	tabletCert      = flag.String("schema-from-tablet-cert", "", "The cert to use to connect to the schema-from-tablet vttablet")
+	tabletKey       = flag.String("schema-from-tablet-key", "", "The key to use to connect to the schema-from-tablet vttablet")
+	tabletCA        = flag.String("schema-from-tablet-ca", "", "The server ca to use to validate the schema-from-tablet vttablet")
+	tabletName      = flag.String("schema-from-tablet-server-name", "", "The server name to use to validate the schema-from-tablet vttablet")
 	vschemaFlag     = flag.String("vschema", "", "Identifies the VTGate routing schema")
 	vschemaFileFlag = flag.String("vschema-file", "", "Identifies the VTGate routing schema file")
 	numShards       = flag.Int("shards", 2, "Number of shards per keyspace")
 	replicationMode = flag.String("replication-mode", "ROW", "The replication mode to simulate -- must be set to either ROW or STATEMENT")
 	normalize       = flag.Bool("normalize", false, "Whether to enable vtgate normalization")
-	outputMode      = flag.String("output-mode", "text", "Output in human-friendly text or json")
+	outputMode      = flag.String("output-mode", "text", "Output in human-friendly text or json, markdown for a table of the mysql queries of each shard, or tablet-json for just the queries sent to each tablet with typed bind variables")
+	numRows         = flag.Int("rows", 1, "Number of rows returned by each simulated query on the tablets")
+	rowsPerTable    = flag.String("rows-per-table", "", "JSON map of table name to the number of rows returned by simulated queries on that table")
+	errorQueries    = flag.String("error-queries", "", "JSON map of query regexp to the error message returned by mysql for matching queries")
+	durations       = flag.String("statement-durations", "", "JSON map of statement kind (select, insert, replace, update or delete) to the number of logical time units it takes on the tablets")
+	shardLatencies  = flag.String("shard-latencies", "", `JSON map of shard name to the latency of its queries in logical time units, e.g. {"-80": {"Base": 2, "Jitter": 3}} for 2 to 5 units`)
+	latencySeed     = flag.Int64("latency-seed", 0, "The seed of the random jitter of the shard-latencies")
+	columnValues    = flag.String("column-values-file", "", "Identifies a file with a JSON map of table.column to the list of values used in turn for that column in simulated query results")
+	columnTypes     = flag.String("column-types", "", "JSON map of table.column to the type name (e.g. VARBINARY) used for that column instead of the one derived from the schema")
+	collations      = flag.String("collations", "", "JSON map of table or table.column to the collation (e.g. utf8_bin) of the text columns of that table or of that column instead of the one in the schema. Values of columns with a _ci collation are compared case-insensitively")
+	streamBatchSize = flag.Int("stream-batch-size", 0, "Number of rows of each result sent back by the tablets for streaming queries, zero to send them as the tabletserver buffers them")
+	multiStatements = flag.Bool("multi-statements", false, "Whether the simulated mysql accepts multiple semicolon-separated statements in a single query")
+	unixTimestamp   = flag.Int64("unix-timestamp", 0, "The unix time of the simulated mysql clock, used for unix_timestamp() and the values of temporal columns")
+	replaceConflict = flag.Bool("replace-conflicts", false, "Whether each row written by a simulated REPLACE replaces an existing row, counting as 2 affected rows as in mysql")
+	dupKeyConflict  = flag.Bool("duplicate-key-conflicts", false, "Whether each row written by a simulated INSERT ... ON DUPLICATE KEY UPDATE updates an existing row, counting as 2 affected rows as in mysql")
+	insertValues    = flag.Bool("record-insert-values", false, "Whether the values of each row written by a simulated INSERT or REPLACE are recorded on the tablet and mysql queries in the json output")
+	strictNotNull   = flag.Bool("strict-not-null", false, "Whether simulated inserts fail when they don't set a not null column that has no default, as in mysql")
+	bindVarTypes    = flag.Bool("check-bind-var-types", false, "Whether the simulated tablets reject queries that compare a column for equality with a bind variable that mysql would have to convert, e.g. a string for an integer column")
+	timeZone        = flag.String("time-zone", "", "The session time_zone of the simulated mysql, e.g. +05:30 or Europe/Paris, which the values of temporal columns are in. The SYSTEM zone, UTC, if empty")
+	sqlMode         = flag.String("sql-mode", "", "The global sql_mode of the simulated mysql, STRICT_TRANS_TABLES if empty")
+	mysqlVersion    = flag.String("mysql-version", "", "The server version reported by the simulated mysql for @@version and version(), 5.7.20 if empty")
+	queryTimeout    = flag.Duration("query-timeout", 0, "The timeout of each statement executed by vtgate, zero for none")
+	queryLatency    = flag.Duration("query-latency", 0, "The simulated time each query takes on the tablets, which fail the queries that would exceed the query-timeout")
+	schemaJSON      = flag.Bool("schema-json", false, "Print the schema as interpreted by vtexplain, as JSON, instead of explaining the sql")
+	warnFullScans   = flag.Bool("warn-full-scans", false, "Whether to print a warning for each select run on the tablets that doesn't constrain an indexed column of some of its tables")
 
 	// vtexplainFlags lists all the flags that should show in usage
 	vtexplainFlags = []string{
@@ -47,8 +83,37 @@ var (
 		"normalize",
 		"shards",
 		"replication-mode",
+		"rows",
+		"rows-per-table",
+		"error-queries",
+		"statement-durations",
+		"shard-latencies",
+		"latency-seed",
+		"multi-statements",
+		"stream-batch-size",
+		"replace-conflicts",
+		"duplicate-key-conflicts",
+		"record-insert-values",
+		"strict-not-null",
+		"check-bind-var-types",
+		"sql-mode",
+		"mysql-version",
+		"query-timeout",
+		"query-latency",
+		"unix-timestamp",
+		"time-zone",
+		"column-values-file",
+		"column-types",
+		"collations",
+		"warn-full-scans",
+		"schema-json",
 		"schema",
 		"schema-file",
+		"schema-from-tablet",
+		"schema-from-tablet-cert",
+		"schema-from-tablet-key",
+		"schema-from-tablet-ca",
+		"schema-from-tablet-server-name",
 		"sql",
 		"sql-file",
 		"vschema",
@@ -145,12 +210,26 @@ func main() {
 }
 
 func parseAndRun() error {
-	sql, err := getFileParam(*sqlFlag, *sqlFileFlag, "sql")
-	if err != nil {
-		return err
+	var sql string
+	var err error
+	if !*schemaJSON {
+		sql, err = getFileParam(*sqlFlag, *sqlFileFlag, "sql")
+		if err != nil {
+			return err
+		}
 	}
 
-	schema, err := getFileParam(*schemaFlag, *schemaFileFlag, "schema")
+	var schema string
+	if *schemaTablet != "" {
+		if *schemaFlag != "" || *schemaFileFlag != "" {
+			return fmt.Errorf("action requires only one of schema, schema-file or schema-from-tablet")
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		schema, err = vtexplain.LoadTabletSchema(ctx, *schemaTablet, *tabletCert, *tabletKey, *tabletCA, *tabletName)
+	} else {
+		schema, err = getFileParam(*schemaFlag, *schemaFileFlag, "schema")
+	}
 	if err != nil {
 		return err
 	}
@@ -161,9 +240,79 @@ func parseAndRun() error {
 	}
 
 	opts := &vtexplain.Options{
-		ReplicationMode: *replicationMode,
-		NumShards:       *numShards,
-		Normalize:       *normalize,
+		ReplicationMode:      *replicationMode,
+		NumShards:            *numShards,
+		Normalize:            *normalize,
+		NumRows:              *numRows,
+		MultiStatements:      *multiStatements,
+		StreamBatchSize:      *streamBatchSize,
+		ReplaceConflict:      *replaceConflict,
+		DuplicateKeyConflict: *dupKeyConflict,
+		RecordInsertValues:   *insertValues,
+		StrictNotNull:        *strictNotNull,
+		CheckBindVarTypes:    *bindVarTypes,
+		SQLMode:              *sqlMode,
+		MysqlVersion:         *mysqlVersion,
+		QueryTimeout:         *queryTimeout,
+		QueryLatency:         *queryLatency,
+		UnixTimestamp:        *unixTimestamp,
+		TimeZone:             *timeZone,
+		LatencySeed:          *latencySeed,
+	}
+
+	if *rowsPerTable != "" {
+		if err := json.Unmarshal([]byte(*rowsPerTable), &opts.RowsPerTable); err != nil {
+			return fmt.Errorf("invalid rows-per-table: %v", err)
+		}
+	}
+
+	if *errorQueries != "" {
+		if err := json.Unmarshal([]byte(*errorQueries), &opts.ErrorQueries); err != nil {
+			return fmt.Errorf("invalid error-queries: %v", err)
+		}
+	}
+
+	if *durations != "" {
+		if err := json.Unmarshal([]byte(*durations), &opts.StatementDurations); err != nil {
+			return fmt.Errorf("invalid statement-durations: %v", err)
+		}
+	}
+
+	if *shardLatencies != "" {
+		if err := json.Unmarshal([]byte(*shardLatencies), &opts.ShardLatencies); err != nil {
+			return fmt.Errorf("invalid shard-latencies: %v", err)
+		}
+	}
+
+	if *columnValues != "" {
+		data, err := ioutil.ReadFile(*columnValues)
+		if err != nil {
+			return fmt.Errorf("Cannot read file %v: %v", *columnValues, err)
+		}
+		if err := json.Unmarshal(data, &opts.ColumnValues); err != nil {
+			return fmt.Errorf("invalid column-values-file: %v", err)
+		}
+	}
+
+	if *columnTypes != "" {
+		var typeNames map[string]string
+		if err := json.Unmarshal([]byte(*columnTypes), &typeNames); err != nil {
+			return fmt.Errorf("invalid column-types: %v", err)
+		}
+		opts.ColumnTypeOverrides = make(map[string]querypb.Type)
+		for column, name := range typeNames {
+			typ, ok := querypb.Type_value[strings.ToUpper(name)]
+			if !ok {
+				return fmt.Errorf("invalid column-types: unknown type %s for %s", name, column)
+			}
+			opts.ColumnTypeOverrides[column] = querypb.Type(typ)
+		}
+	}
+
+	if *collations != "" {
+		if err := json.Unmarshal([]byte(*collations), &opts.Collations); err != nil {
+			return fmt.Errorf("invalid collations: %v", err)
+		}
 	}
 
 	log.V(100).Infof("sql %s\n", sql)
@@ -175,14 +324,30 @@ func parseAndRun() error {
 		return err
 	}
 
+	if *schemaJSON {
+		fmt.Print(vtexplain.SchemaAsJSON())
+		return nil
+	}
+
 	plans, err := vtexplain.Run(sql)
 	if err != nil {
 		return err
 	}
 
-	if *outputMode == "text" {
+	if *warnFullScans {
+		for _, scan := range vtexplain.FullScans(plans) {
+			fmt.Fprintf(os.Stderr, "WARNING: %s: possible full scan of %s: %s\n", scan.Tablet, strings.Join(scan.Tables, ", "), scan.SQL)
+		}
+	}
+
+	switch *outputMode {
+	case "text":
 		fmt.Print(vtexplain.ExplainsAsText(plans))
-	} else {
+	case "markdown":
+		fmt.Print(vtexplain.ExplainsAsMarkdown(plans))
+	case "tablet-json":
+		fmt.Print(vtexplain.TabletQueriesAsJSON(plans))
+	default:
 		fmt.Print(vtexplain.ExplainsAsJSON(plans))
 	}
 
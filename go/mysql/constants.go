@@ -422,6 +422,7 @@ const (
 	ERTableNameNotAllowedHere      = 1250
 	ERDataTooLong                  = 1406
 	ERDataOutOfRange               = 1690
+	ERNoDefaultForField            = 1364
 	ERTruncatedWrongValueForField  = 1366
 )
 
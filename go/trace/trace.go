@@ -86,6 +86,30 @@ type SpanFactory interface {
 	NewContext(parent context.Context, span Span) context.Context
 }
 
+// SpanInjector is implemented by the SpanFactory of plugins that can
+// propagate spans to other processes, for instance in the metadata of
+// RPCs. Inject writes the span as key/value pairs with set.
+type SpanInjector interface {
+	Inject(span Span, set func(key, value string)) error
+}
+
+// CanInject returns true if the installed tracing plugin implements
+// SpanInjector.
+func CanInject() bool {
+	_, ok := spanFactory.(SpanInjector)
+	return ok
+}
+
+// Inject writes span with set if the installed tracing plugin implements
+// SpanInjector. It returns false if the plugin can't propagate spans.
+func Inject(span Span, set func(key, value string)) (bool, error) {
+	injector, ok := spanFactory.(SpanInjector)
+	if !ok {
+		return false, nil
+	}
+	return true, injector.Inject(span, set)
+}
+
 var spanFactory SpanFactory = fakeSpanFactory{}
 
 // RegisterSpanFactory should be called by a plugin during init() to install a
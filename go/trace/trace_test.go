@@ -35,4 +35,15 @@ func TestFakeSpan(t *testing.T) {
 	span.Finish()
 	NewContext(ctx, span)
 	CopySpan(ctx, ctx)
+
+	// The fake plugin doesn't propagate spans.
+	if CanInject() {
+		t.Errorf("CanInject with the fake plugin: true, want false")
+	}
+	injected, err := Inject(span, func(key, value string) {
+		t.Errorf("Inject with the fake plugin wrote %v=%v", key, value)
+	})
+	if injected || err != nil {
+		t.Errorf("Inject with the fake plugin: %v, %v, want false, nil", injected, err)
+	}
 }
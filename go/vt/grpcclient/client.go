@@ -17,49 +17,273 @@ limitations under the License.
 package grpcclient
 
 import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/naming"
 
 	"github.com/youtube/vitess/go/vt/grpccommon"
 	"github.com/youtube/vitess/go/vt/vttls"
 )
 
-// Dial creates a grpc connection to the given target.
+// unixPrefix is the prefix of targets that are the path of a unix socket.
+const unixPrefix = "unix://"
+
+// dialTarget is a target of Dial split into its parts.
+type dialTarget struct {
+	// network is "unix" for unix sockets, "tcp" for host:port
+	// addresses, and empty for the targets grpc resolves itself,
+	// for instance the ones of a registered resolver scheme.
+	network string
+	// addr is the path of the unix socket, or the target itself.
+	addr string
+	// host and port are the parts of a host:port address. The host
+	// of an IPv6 address has no brackets.
+	host, port string
+}
+
+// parseTarget splits a target of Dial. It uses net.SplitHostPort so
+// that IPv6 addresses like [::1]:15991 are not mistaken for a scheme
+// or split at the wrong colon.
+func parseTarget(target string) dialTarget {
+	if strings.HasPrefix(target, unixPrefix) {
+		return dialTarget{
+			network: "unix",
+			addr:    strings.TrimPrefix(target, unixPrefix),
+		}
+	}
+	if strings.Contains(target, "://") {
+		return dialTarget{addr: target}
+	}
+	host, port, err := net.SplitHostPort(target)
+	if err != nil {
+		// Let grpc report the error, or use the dialer given in the
+		// options, which may not expect a host:port address.
+		return dialTarget{addr: target}
+	}
+	return dialTarget{
+		network: "tcp",
+		addr:    target,
+		host:    host,
+		port:    port,
+	}
+}
+
+// Dial creates a grpc connection to the given target. It blocks until the
+// connection is established.
+// The target is either a host:port address, with brackets around IPv6
+// addresses as in [::1]:15991, or unix:// followed by the path of a unix
+// socket, for instance for co-located processes. Like for any other
+// target, opts must set the transport security, see DialUnix.
+// The interceptors registered with RegisterUnaryClientInterceptor and
+// RegisterStreamClientInterceptor are installed on the connection. An
+// interceptor set in opts with grpc.WithUnaryInterceptor or
+// grpc.WithStreamInterceptor replaces them all.
 func Dial(target string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	return DialContext(context.Background(), target, true, opts...)
+}
+
+// DialUnix creates a grpc connection to the unix socket at path. It
+// uses TLS with creds, or no transport security if creds is nil, which
+// is usually enough for co-located processes.
+func DialUnix(path string, creds credentials.TransportCredentials, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	security := grpc.WithInsecure()
+	if creds != nil {
+		security = grpc.WithTransportCredentials(creds)
+	}
+	return Dial(unixPrefix+path, append([]grpc.DialOption{security}, opts...)...)
+}
+
+// DialContext creates a grpc connection to the given target. If block is
+// set, it waits until the connection is established, ctx is done or
+// -grpc_connect_timeout expires. Otherwise it returns right away and the
+// connection is established in the background, so that errors only
+// surface on the RPCs that use it.
+func DialContext(ctx context.Context, target string, block bool, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	return dialContext(ctx, target, block, nil, opts...)
+}
+
+// dialContext is DialContext, also counting the RPCs of the connection
+// with tracker if it is not nil.
+func dialContext(ctx context.Context, target string, block bool, tracker *rpcTracker, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	dt := parseTarget(target)
+	if block && *grpccommon.ConnectTimeout != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *grpccommon.ConnectTimeout)
+		defer cancel()
+	}
 	newopts := []grpc.DialOption{
 		grpc.WithDefaultCallOptions(
 			grpc.MaxCallRecvMsgSize(*grpccommon.MaxMessageSize),
 			grpc.MaxCallSendMsgSize(*grpccommon.MaxMessageSize),
 		),
-		// FailOnNonTempDialError makes the grpc fail faster on chronic errors.
-		// Additionally, the error messages are more specific, which
-		// is more helpful for troubleshooting.
-		grpc.FailOnNonTempDialError(true),
-		// With grpc 1.7.0, some requests are failing with
-		// 'the connection is unavailable' error. Adding this
-		// WithBlock option mitigates the problem.
-		grpc.WithBlock(),
+		grpc.WithBackoffMaxDelay(*grpccommon.MaxBackoff),
+	}
+	if block {
+		newopts = append(newopts,
+			// FailOnNonTempDialError makes the grpc fail faster on chronic errors.
+			// Additionally, the error messages are more specific, which
+			// is more helpful for troubleshooting.
+			grpc.FailOnNonTempDialError(true),
+			// With grpc 1.7.0, some requests are failing with
+			// 'the connection is unavailable' error. Adding this
+			// WithBlock option mitigates the problem.
+			grpc.WithBlock(),
+		)
+	}
+	// Keepalive pings detect connections that were silently dropped,
+	// for instance by load balancers closing idle connections.
+	if *grpccommon.KeepaliveTime != 0 {
+		newopts = append(newopts, grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                *grpccommon.KeepaliveTime,
+			Timeout:             *grpccommon.KeepaliveTimeout,
+			PermitWithoutStream: *grpccommon.KeepalivePermitWithoutStream,
+		}))
+	}
+	switch *grpccommon.ClientCompression {
+	case "":
+	case "gzip":
+		newopts = append(newopts,
+			grpc.WithCompressor(grpc.NewGZIPCompressor()),
+			grpc.WithDecompressor(grpc.NewGZIPDecompressor()),
+		)
+	default:
+		return nil, fmt.Errorf("unsupported grpc_client_compression %q", *grpccommon.ClientCompression)
+	}
+	switch *grpccommon.ClientLoadBalancing {
+	case "", "pick_first":
+	case "round_robin":
+		// Balance across all the addresses DNS returns for the target.
+		if dt.network == "tcp" {
+			r, err := naming.NewDNSResolver()
+			if err != nil {
+				return nil, err
+			}
+			newopts = append(newopts, grpc.WithBalancer(grpc.RoundRobin(r)))
+		}
+	default:
+		return nil, fmt.Errorf("unsupported grpc_client_load_balancing %q", *grpccommon.ClientLoadBalancing)
+	}
+	if *grpccommon.EnableClientStats {
+		newopts = append(newopts, grpc.WithStatsHandler(&statsHandler{target: target}))
 	}
+	newopts = append(newopts, interceptorDialOptions(target, tracker)...)
+	// The options of the caller come last so that they win, for
+	// instance to use MaxMessageSizeDialOption.
 	newopts = append(newopts, opts...)
-	return grpc.Dial(target, newopts...)
+	if dt.network == "unix" {
+		target = dt.addr
+		newopts = append(newopts,
+			grpc.WithDialer(func(addr string, timeout time.Duration) (net.Conn, error) {
+				return net.DialTimeout("unix", addr, timeout)
+			}),
+		)
+	}
+	return grpc.DialContext(ctx, target, newopts...)
+}
+
+// MaxMessageSizeDialOption returns the dial option to use other max
+// message sizes than -grpc_max_message_size for a connection, for
+// instance for clients that transfer more data than the rest of the
+// cluster.
+func MaxMessageSizeDialOption(recv, send int) grpc.DialOption {
+	return grpc.WithDefaultCallOptions(
+		grpc.MaxCallRecvMsgSize(recv),
+		grpc.MaxCallSendMsgSize(send),
+	)
 }
 
 // SecureDialOption returns the gRPC dial option to use for the
 // given client connection. It is either using TLS, or Insecure if
 // nothing is set.
 func SecureDialOption(cert, key, ca, name string) (grpc.DialOption, error) {
+	config, err := clientTLSConfig(cert, key, ca, name)
+	if err != nil {
+		return nil, err
+	}
+
 	// No security options set, just return.
-	if (cert == "" || key == "") && ca == "" {
+	if config == nil {
 		return grpc.WithInsecure(), nil
 	}
 
+	// Create the creds server options.
+	creds := credentials.NewTLS(config)
+	return grpc.WithTransportCredentials(creds), nil
+}
+
+// ValidateClientTLS checks that the TLS options of a client can be used
+// by SecureDialOption, so that misconfigurations are reported at startup
+// rather than on the first connection. It loads and parses the cert, key
+// and ca files, returning a *vttls.ConfigError naming the file at fault,
+// and also rejects a cert without a key or a key without a cert, which
+// SecureDialOption would silently ignore.
+func ValidateClientTLS(cert, key, ca, name string) error {
+	if (cert == "") != (key == "") {
+		return fmt.Errorf("the client cert and key must be set together, got cert %q and key %q", cert, key)
+	}
+	_, err := clientTLSConfig(cert, key, ca, name)
+	return err
+}
+
+// clientTLSConfig loads the TLS config of a client, or returns nil if
+// neither a cert and key nor a ca is set.
+func clientTLSConfig(cert, key, ca, name string) (*tls.Config, error) {
+	if (cert == "" || key == "") && ca == "" {
+		return nil, nil
+	}
+
 	// Load the config.
 	config, err := vttls.ClientConfig(cert, key, ca, name)
 	if err != nil {
 		return nil, err
 	}
+	if err := setTLSMinVersion(config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
 
-	// Create the creds server options.
-	creds := credentials.NewTLS(config)
-	return grpc.WithTransportCredentials(creds), nil
+// SecureDialOptionFromPEM is like SecureDialOption, but takes the PEM
+// data of the cert, key and ca instead of files, so that secrets held
+// in memory don't have to be written to disk.
+func SecureDialOptionFromPEM(cert, key, ca []byte, name string) (grpc.DialOption, error) {
+	// No security options set, just return.
+	if (len(cert) == 0 || len(key) == 0) && len(ca) == 0 {
+		return grpc.WithInsecure(), nil
+	}
+
+	config, err := vttls.ClientConfigFromPEM(cert, key, ca, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := setTLSMinVersion(config); err != nil {
+		return nil, err
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(config)), nil
+}
+
+// tlsVersions maps the values of -grpc_tls_min_version to TLS versions.
+var tlsVersions = map[string]uint16{
+	"1.0": tls.VersionTLS10,
+	"1.1": tls.VersionTLS11,
+	"1.2": tls.VersionTLS12,
+}
+
+// setTLSMinVersion sets the minimum version of config from
+// -grpc_tls_min_version.
+func setTLSMinVersion(config *tls.Config) error {
+	version, ok := tlsVersions[*grpccommon.TLSMinVersion]
+	if !ok {
+		return fmt.Errorf("unsupported grpc_tls_min_version %q", *grpccommon.TLSMinVersion)
+	}
+	config.MinVersion = version
+	return nil
 }
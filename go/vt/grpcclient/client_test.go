@@ -17,12 +17,36 @@ limitations under the License.
 package grpcclient
 
 import (
+	"crypto/tls"
+	"io/ioutil"
+	"net"
+	"os"
+	"path"
 	"strings"
 	"testing"
+	"time"
 
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/youtube/vitess/go/vt/grpccommon"
+	"github.com/youtube/vitess/go/vt/tlstest"
+	"github.com/youtube/vitess/go/vt/vttls"
 )
 
+// startTestServer starts a grpc server without services on a local port.
+// It returns its address, and a function that stops it.
+func startTestServer(t *testing.T) (string, func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	server := grpc.NewServer()
+	go server.Serve(listener)
+	return listener.Addr().String(), server.Stop
+}
+
 func TestDialErrors(t *testing.T) {
 	tcases := []struct {
 		address, err string
@@ -43,3 +67,355 @@ func TestDialErrors(t *testing.T) {
 		}
 	}
 }
+
+func TestDialContext(t *testing.T) {
+	// Without blocking, dialing an unreachable address succeeds and the
+	// connection is retried in the background.
+	address := "[::]:12346"
+	cc, err := DialContext(context.Background(), address, false, grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("DialContext(%s, false): %v", address, err)
+	}
+	cc.Close()
+
+	// When blocking, dialing fails if the connection can't be established
+	// before the context is done.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := DialContext(ctx, "localhost:0", true, grpc.WithInsecure()); err == nil {
+		t.Errorf("DialContext(localhost:0, true): expected error")
+	}
+}
+
+func TestDialCompression(t *testing.T) {
+	defer func(compression string) {
+		*grpccommon.ClientCompression = compression
+	}(*grpccommon.ClientCompression)
+
+	*grpccommon.ClientCompression = "gzip"
+	cc, err := DialContext(context.Background(), "[::]:12346", false, grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("DialContext with gzip compression: %v", err)
+	}
+	cc.Close()
+
+	*grpccommon.ClientCompression = "snappy"
+	want := `unsupported grpc_client_compression "snappy"`
+	if _, err := Dial("[::]:12346", grpc.WithInsecure()); err == nil || err.Error() != want {
+		t.Errorf("Dial with snappy compression: %v, want %s", err, want)
+	}
+}
+
+func TestDialUnix(t *testing.T) {
+	dir, err := ioutil.TempDir("", "grpcclient")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	socket := path.Join(dir, "grpc.sock")
+	listener, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	server := grpc.NewServer()
+	go server.Serve(listener)
+	defer server.Stop()
+
+	// Like other targets, unix sockets need a transport security.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if cc, err := DialContext(ctx, "unix://"+socket, true); err == nil {
+		cc.Close()
+		t.Errorf("DialContext(unix://%v) without transport security succeeded, want error", socket)
+	}
+	cc, err := DialContext(ctx, "unix://"+socket, true, grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("DialContext(unix://%v): %v", socket, err)
+	}
+	cc.Close()
+
+	cc, err = DialUnix(socket, nil)
+	if err != nil {
+		t.Fatalf("DialUnix(%v, nil): %v", socket, err)
+	}
+	cc.Close()
+
+	// With credentials, TLS fails against the plaintext server.
+	defer func(timeout time.Duration) {
+		*grpccommon.ConnectTimeout = timeout
+	}(*grpccommon.ConnectTimeout)
+	*grpccommon.ConnectTimeout = time.Second
+	if cc, err := DialUnix(socket, credentials.NewTLS(&tls.Config{})); err == nil {
+		cc.Close()
+		t.Errorf("DialUnix(%v) with TLS succeeded, want error", socket)
+	}
+}
+
+func TestParseTarget(t *testing.T) {
+	tcases := []struct {
+		target string
+		want   dialTarget
+	}{{
+		target: "10.0.0.1:15991",
+		want:   dialTarget{network: "tcp", addr: "10.0.0.1:15991", host: "10.0.0.1", port: "15991"},
+	}, {
+		target: "[::1]:15991",
+		want:   dialTarget{network: "tcp", addr: "[::1]:15991", host: "::1", port: "15991"},
+	}, {
+		target: "[fe80::1%eth0]:15991",
+		want:   dialTarget{network: "tcp", addr: "[fe80::1%eth0]:15991", host: "fe80::1%eth0", port: "15991"},
+	}, {
+		target: "vttablet-0.example.com:15991",
+		want:   dialTarget{network: "tcp", addr: "vttablet-0.example.com:15991", host: "vttablet-0.example.com", port: "15991"},
+	}, {
+		target: "unix:///tmp/vt/grpc.sock",
+		want:   dialTarget{network: "unix", addr: "/tmp/vt/grpc.sock"},
+	}, {
+		target: "vitess:///test_keyspace/0",
+		want:   dialTarget{addr: "vitess:///test_keyspace/0"},
+	}, {
+		// Without brackets, the port of an IPv6 address is ambiguous.
+		target: "::1:15991",
+		want:   dialTarget{addr: "::1:15991"},
+	}, {
+		target: "/vt/mysqlctl.sock",
+		want:   dialTarget{addr: "/vt/mysqlctl.sock"},
+	}}
+	for _, tcase := range tcases {
+		if got := parseTarget(tcase.target); got != tcase.want {
+			t.Errorf("parseTarget(%v): %+v, want %+v", tcase.target, got, tcase.want)
+		}
+	}
+}
+
+func TestDialTargets(t *testing.T) {
+	defer func(balancing string) {
+		*grpccommon.ClientLoadBalancing = balancing
+	}(*grpccommon.ClientLoadBalancing)
+
+	for _, address := range []string{"127.0.0.1:0", "[::1]:0", "localhost:0"} {
+		listener, err := net.Listen("tcp", address)
+		if err != nil {
+			t.Logf("Listen(%v) failed, skipping: %v", address, err)
+			continue
+		}
+		server := grpc.NewServer()
+		go server.Serve(listener)
+
+		_, port, err := net.SplitHostPort(listener.Addr().String())
+		if err != nil {
+			t.Fatalf("SplitHostPort(%v) failed: %v", listener.Addr(), err)
+		}
+		host, _, _ := net.SplitHostPort(address)
+		target := net.JoinHostPort(host, port)
+		for _, balancing := range []string{"", "round_robin"} {
+			*grpccommon.ClientLoadBalancing = balancing
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			cc, err := DialContext(ctx, target, true, grpc.WithInsecure())
+			cancel()
+			if err != nil {
+				t.Errorf("DialContext(%v) with load balancing %q: %v", target, balancing, err)
+				continue
+			}
+			cc.Close()
+		}
+		server.Stop()
+	}
+}
+
+func TestDialLoadBalancing(t *testing.T) {
+	defer func(balancing string) {
+		*grpccommon.ClientLoadBalancing = balancing
+	}(*grpccommon.ClientLoadBalancing)
+
+	*grpccommon.ClientLoadBalancing = "round_robin"
+	cc, err := DialContext(context.Background(), "localhost:12346", false, grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("DialContext with round_robin: %v", err)
+	}
+	cc.Close()
+
+	*grpccommon.ClientLoadBalancing = "random"
+	want := `unsupported grpc_client_load_balancing "random"`
+	if _, err := Dial("localhost:12346", grpc.WithInsecure()); err == nil || err.Error() != want {
+		t.Errorf("Dial with random load balancing: %v, want %s", err, want)
+	}
+}
+
+func TestSecureDialOptionErrors(t *testing.T) {
+	root, err := ioutil.TempDir("", "grpcclient")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	tlstest.CreateCA(root)
+	tlstest.CreateSignedCert(root, tlstest.CA, "01", "client", "Client")
+	tlstest.CreateSignedCert(root, tlstest.CA, "02", "other", "Other")
+	notPEM := path.Join(root, "not.pem")
+	if err := ioutil.WriteFile(notPEM, []byte("garbage"), 0600); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	cert := path.Join(root, "client-cert.pem")
+	key := path.Join(root, "client-key.pem")
+	ca := path.Join(root, "ca-cert.pem")
+
+	testcases := []struct {
+		desc         string
+		cert, key    string
+		ca           string
+		input        string
+		path         string
+		invalidPEM   bool
+		keyMismatch  bool
+		fileNotExist bool
+	}{{
+		desc:         "missing cert",
+		cert:         path.Join(root, "missing.pem"),
+		key:          key,
+		input:        vttls.InputCert,
+		path:         path.Join(root, "missing.pem"),
+		fileNotExist: true,
+	}, {
+		desc:       "invalid key",
+		cert:       cert,
+		key:        notPEM,
+		input:      vttls.InputKey,
+		path:       notPEM,
+		invalidPEM: true,
+	}, {
+		desc:        "mismatched key",
+		cert:        cert,
+		key:         path.Join(root, "other-key.pem"),
+		input:       vttls.InputKey,
+		path:        path.Join(root, "other-key.pem"),
+		keyMismatch: true,
+	}, {
+		desc:       "invalid ca",
+		cert:       cert,
+		key:        key,
+		ca:         notPEM,
+		input:      vttls.InputCA,
+		path:       notPEM,
+		invalidPEM: true,
+	}}
+	for _, tcase := range testcases {
+		_, err := SecureDialOption(tcase.cert, tcase.key, tcase.ca, "")
+		configErr, ok := err.(*vttls.ConfigError)
+		if !ok {
+			t.Errorf("%s: got error %v, want a *vttls.ConfigError", tcase.desc, err)
+			continue
+		}
+		if configErr.Input != tcase.input || configErr.Path != tcase.path {
+			t.Errorf("%s: got error for %v %v, want %v %v", tcase.desc, configErr.Input, configErr.Path, tcase.input, tcase.path)
+		}
+		if got := configErr.Err == vttls.ErrInvalidPEM; got != tcase.invalidPEM {
+			t.Errorf("%s: invalid PEM: %v, want %v (%v)", tcase.desc, got, tcase.invalidPEM, err)
+		}
+		if got := configErr.Err == vttls.ErrKeyMismatch; got != tcase.keyMismatch {
+			t.Errorf("%s: key mismatch: %v, want %v (%v)", tcase.desc, got, tcase.keyMismatch, err)
+		}
+		if got := os.IsNotExist(configErr.Err); got != tcase.fileNotExist {
+			t.Errorf("%s: file not exist: %v, want %v (%v)", tcase.desc, got, tcase.fileNotExist, err)
+		}
+	}
+
+	if _, err := SecureDialOption(cert, key, ca, ""); err != nil {
+		t.Errorf("SecureDialOption with valid files failed: %v", err)
+	}
+}
+
+func TestValidateClientTLS(t *testing.T) {
+	root, err := ioutil.TempDir("", "grpcclient")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	tlstest.CreateCA(root)
+	tlstest.CreateSignedCert(root, tlstest.CA, "01", "client", "Client")
+	cert := path.Join(root, "client-cert.pem")
+	key := path.Join(root, "client-key.pem")
+	ca := path.Join(root, "ca-cert.pem")
+
+	for _, args := range [][3]string{
+		{"", "", ""},
+		{"", "", ca},
+		{cert, key, ""},
+		{cert, key, ca},
+	} {
+		if err := ValidateClientTLS(args[0], args[1], args[2], "server"); err != nil {
+			t.Errorf("ValidateClientTLS(%v) failed: %v", args, err)
+		}
+	}
+
+	for _, args := range [][2]string{{cert, ""}, {"", key}} {
+		if err := ValidateClientTLS(args[0], args[1], ca, ""); err == nil {
+			t.Errorf("ValidateClientTLS(%v) without both cert and key: expected error", args)
+		}
+	}
+
+	missing := path.Join(root, "missing.pem")
+	err = ValidateClientTLS(cert, key, missing, "")
+	if configErr, ok := err.(*vttls.ConfigError); !ok || configErr.Input != vttls.InputCA || configErr.Path != missing {
+		t.Errorf("ValidateClientTLS with a missing ca: %v, want a *vttls.ConfigError for %v", err, missing)
+	}
+}
+
+func TestSecureDialOptionFromPEM(t *testing.T) {
+	root, err := ioutil.TempDir("", "grpcclient")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	tlstest.CreateCA(root)
+	tlstest.CreateSignedCert(root, tlstest.CA, "01", "client", "Client")
+	read := func(name string) []byte {
+		data, err := ioutil.ReadFile(path.Join(root, name))
+		if err != nil {
+			t.Fatalf("ReadFile failed: %v", err)
+		}
+		return data
+	}
+	cert, key, ca := read("client-cert.pem"), read("client-key.pem"), read("ca-cert.pem")
+
+	if _, err := SecureDialOptionFromPEM(nil, nil, nil, ""); err != nil {
+		t.Errorf("SecureDialOptionFromPEM without data failed: %v", err)
+	}
+	if _, err := SecureDialOptionFromPEM(cert, key, ca, "server"); err != nil {
+		t.Errorf("SecureDialOptionFromPEM failed: %v", err)
+	}
+
+	// The errors are the same as with files, without path.
+	_, err = SecureDialOptionFromPEM(cert, ca, nil, "")
+	want := "failed to load key: "
+	if err == nil || !strings.HasPrefix(err.Error(), want) {
+		t.Errorf("SecureDialOptionFromPEM with a cert as key: %v, want prefix %v", err, want)
+	}
+	_, err = SecureDialOptionFromPEM(nil, nil, []byte("garbage"), "")
+	if configErr, ok := err.(*vttls.ConfigError); !ok || configErr.Input != vttls.InputCA || configErr.Err != vttls.ErrInvalidPEM {
+		t.Errorf("SecureDialOptionFromPEM with an invalid ca: %v, want ErrInvalidPEM for the ca", err)
+	}
+}
+
+func TestTLSMinVersion(t *testing.T) {
+	defer func(version string) {
+		*grpccommon.TLSMinVersion = version
+	}(*grpccommon.TLSMinVersion)
+
+	config := &tls.Config{}
+	if err := setTLSMinVersion(config); err != nil {
+		t.Fatalf("setTLSMinVersion failed: %v", err)
+	}
+	if config.MinVersion != tls.VersionTLS12 {
+		t.Errorf("default MinVersion: %x, want TLS 1.2", config.MinVersion)
+	}
+
+	*grpccommon.TLSMinVersion = "ssl3"
+	want := `unsupported grpc_tls_min_version "ssl3"`
+	if err := setTLSMinVersion(config); err == nil || err.Error() != want {
+		t.Errorf("setTLSMinVersion(ssl3): %v, want %v", err, want)
+	}
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"sync"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// HealthWatcher follows the connectivity state of a connection, so that
+// callers can avoid unhealthy connections without waiting for an RPC to
+// fail. This is mostly useful for connections that were not dialed with
+// blocking, or that degrade after being established.
+type HealthWatcher struct {
+	cc       *grpc.ClientConn
+	onChange func(connectivity.State)
+	cancel   context.CancelFunc
+	done     chan struct{}
+
+	// mu protects state.
+	mu    sync.Mutex
+	state connectivity.State
+}
+
+// NewHealthWatcher starts watching cc. If onChange is not nil, it is
+// called with every new state, from the goroutine of the watcher.
+// Close must be called to stop watching.
+func NewHealthWatcher(cc *grpc.ClientConn, onChange func(connectivity.State)) *HealthWatcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &HealthWatcher{
+		cc:       cc,
+		onChange: onChange,
+		cancel:   cancel,
+		done:     make(chan struct{}),
+		state:    cc.GetState(),
+	}
+	go w.watch(ctx)
+	return w
+}
+
+func (w *HealthWatcher) watch(ctx context.Context) {
+	defer close(w.done)
+
+	state := w.State()
+	if w.onChange != nil {
+		w.onChange(state)
+	}
+	for {
+		// WaitForStateChange returns false when ctx is canceled.
+		if !w.cc.WaitForStateChange(ctx, state) {
+			return
+		}
+		state = w.cc.GetState()
+		w.mu.Lock()
+		w.state = state
+		w.mu.Unlock()
+		if w.onChange != nil {
+			w.onChange(state)
+		}
+		if state == connectivity.Shutdown {
+			return
+		}
+	}
+}
+
+// State returns the last known state of the connection.
+func (w *HealthWatcher) State() connectivity.State {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.state
+}
+
+// Healthy returns true if the connection is ready for RPCs.
+func (w *HealthWatcher) Healthy() bool {
+	return w.State() == connectivity.Ready
+}
+
+// Close stops watching the connection. It doesn't close it.
+func (w *HealthWatcher) Close() {
+	w.cancel()
+	<-w.done
+}
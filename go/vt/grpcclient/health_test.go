@@ -0,0 +1,63 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+func TestHealthWatcher(t *testing.T) {
+	addr, stop := startTestServer(t)
+	defer stop()
+
+	cc, err := Dial(addr, grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	defer cc.Close()
+
+	states := make(chan connectivity.State, 10)
+	w := NewHealthWatcher(cc, func(state connectivity.State) {
+		states <- state
+	})
+	defer w.Close()
+
+	if !w.Healthy() {
+		t.Errorf("Healthy: false after a blocking Dial, state %v", w.State())
+	}
+	if state := <-states; state != connectivity.Ready {
+		t.Errorf("initial state: %v, want %v", state, connectivity.Ready)
+	}
+
+	// Stopping the server makes the connection unhealthy.
+	stop()
+	select {
+	case state := <-states:
+		if state == connectivity.Ready {
+			t.Errorf("state after the server stopped: %v", state)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no state change after the server stopped")
+	}
+	if w.Healthy() {
+		t.Errorf("Healthy: true after the server stopped")
+	}
+}
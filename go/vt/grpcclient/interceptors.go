@@ -0,0 +1,133 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"sync"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	"github.com/youtube/vitess/go/trace"
+)
+
+// UnaryClientInterceptorFactory returns the interceptor to use for the
+// unary RPCs of a connection to the given target.
+type UnaryClientInterceptorFactory func(target string) grpc.UnaryClientInterceptor
+
+// StreamClientInterceptorFactory returns the interceptor to use for the
+// streaming RPCs of a connection to the given target.
+type StreamClientInterceptorFactory func(target string) grpc.StreamClientInterceptor
+
+var (
+	interceptorsMu  sync.Mutex
+	unaryFactories  []UnaryClientInterceptorFactory
+	streamFactories []StreamClientInterceptorFactory
+)
+
+// RegisterUnaryClientInterceptor registers an interceptor factory for the
+// unary RPCs of all the connections created by Dial from then on, for
+// instance to add metrics or tracing. Interceptors are called in the order
+// in which they were registered. An interceptor passed to Dial with
+// grpc.WithUnaryInterceptor replaces all of them, so the two shouldn't be
+// mixed. This is usually called from init().
+func RegisterUnaryClientInterceptor(factory UnaryClientInterceptorFactory) {
+	interceptorsMu.Lock()
+	defer interceptorsMu.Unlock()
+	unaryFactories = append(unaryFactories, factory)
+}
+
+// RegisterStreamClientInterceptor registers an interceptor factory for the
+// streaming RPCs of all the connections created by Dial from then on.
+// Interceptors are called in the order in which they were registered. An
+// interceptor passed to Dial with grpc.WithStreamInterceptor replaces all
+// of them, so the two shouldn't be mixed. This is usually called from
+// init().
+func RegisterStreamClientInterceptor(factory StreamClientInterceptorFactory) {
+	interceptorsMu.Lock()
+	defer interceptorsMu.Unlock()
+	streamFactories = append(streamFactories, factory)
+}
+
+// interceptorDialOptions returns the dial options that install the
+// registered interceptors for a connection to the given target, after
+// the tracing ones if the tracing plugin can propagate spans, and after
+// the ones of tracker if it is not nil.
+func interceptorDialOptions(target string, tracker *rpcTracker) []grpc.DialOption {
+	interceptorsMu.Lock()
+	defer interceptorsMu.Unlock()
+
+	var unary []grpc.UnaryClientInterceptor
+	var stream []grpc.StreamClientInterceptor
+	// The RPCs are counted first, so that the time spent in the
+	// other interceptors is part of them.
+	if tracker != nil {
+		unary = append(unary, tracker.unaryInterceptor)
+		stream = append(stream, tracker.streamInterceptor)
+	}
+	// The span is propagated first, so that the registered
+	// interceptors see the same metadata as the server.
+	if trace.CanInject() {
+		unary = append(unary, tracingUnaryInterceptor)
+		stream = append(stream, tracingStreamInterceptor)
+	}
+	for _, factory := range unaryFactories {
+		unary = append(unary, factory(target))
+	}
+	for _, factory := range streamFactories {
+		stream = append(stream, factory(target))
+	}
+
+	var interceptorOpts []grpc.DialOption
+	if len(unary) != 0 {
+		interceptorOpts = append(interceptorOpts, grpc.WithUnaryInterceptor(chainUnaryInterceptors(unary)))
+	}
+	if len(stream) != 0 {
+		interceptorOpts = append(interceptorOpts, grpc.WithStreamInterceptor(chainStreamInterceptors(stream)))
+	}
+	return interceptorOpts
+}
+
+// chainUnaryInterceptors returns an interceptor that calls the given
+// interceptors in order, since a connection only accepts a single one.
+func chainUnaryInterceptors(interceptors []grpc.UnaryClientInterceptor) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		chained := invoker
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chained
+			chained = func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				return interceptor(ctx, method, req, reply, cc, next, opts...)
+			}
+		}
+		return chained(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// chainStreamInterceptors returns an interceptor that calls the given
+// interceptors in order, since a connection only accepts a single one.
+func chainStreamInterceptors(interceptors []grpc.StreamClientInterceptor) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		chained := streamer
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], chained
+			chained = func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+				return interceptor(ctx, desc, cc, method, next, opts...)
+			}
+		}
+		return chained(ctx, desc, cc, method, opts...)
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+func TestChainUnaryInterceptors(t *testing.T) {
+	var calls []string
+	interceptor := func(name string) grpc.UnaryClientInterceptor {
+		return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			calls = append(calls, name+" "+method)
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+	}
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		calls = append(calls, "invoker "+method)
+		return nil
+	}
+
+	chained := chainUnaryInterceptors([]grpc.UnaryClientInterceptor{interceptor("first"), interceptor("second")})
+	if err := chained(context.Background(), "/test/Method", nil, nil, nil, invoker); err != nil {
+		t.Fatalf("chained interceptor: %v", err)
+	}
+	want := []string{"first /test/Method", "second /test/Method", "invoker /test/Method"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls: %v, want %v", calls, want)
+	}
+}
+
+func TestChainStreamInterceptors(t *testing.T) {
+	var calls []string
+	interceptor := func(name string) grpc.StreamClientInterceptor {
+		return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			calls = append(calls, name)
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+	}
+	streamer := func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		calls = append(calls, "streamer")
+		return nil, nil
+	}
+
+	chained := chainStreamInterceptors([]grpc.StreamClientInterceptor{interceptor("first"), interceptor("second")})
+	if _, err := chained(context.Background(), &grpc.StreamDesc{}, nil, "/test/Stream", streamer); err != nil {
+		t.Fatalf("chained interceptor: %v", err)
+	}
+	want := []string{"first", "second", "streamer"}
+	if !reflect.DeepEqual(calls, want) {
+		t.Errorf("calls: %v, want %v", calls, want)
+	}
+}
+
+func TestInterceptorDialOptions(t *testing.T) {
+	if opts := interceptorDialOptions("localhost:1", nil); len(opts) != 0 {
+		t.Errorf("interceptorDialOptions without registered interceptors: %v, want none", opts)
+	}
+
+	var targets []string
+	RegisterUnaryClientInterceptor(func(target string) grpc.UnaryClientInterceptor {
+		targets = append(targets, target)
+		return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+	})
+	defer func() {
+		unaryFactories = nil
+	}()
+
+	if opts := interceptorDialOptions("localhost:1", nil); len(opts) != 1 {
+		t.Errorf("interceptorDialOptions: %d options, want 1", len(opts))
+	}
+	if want := []string{"localhost:1"}; !reflect.DeepEqual(targets, want) {
+		t.Errorf("factory called for %v, want %v", targets, want)
+	}
+}
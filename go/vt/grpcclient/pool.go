@@ -0,0 +1,376 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	log "github.com/golang/glog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+
+	"github.com/youtube/vitess/go/vt/grpccommon"
+)
+
+// errPoolClosed is returned by Get once the pool is closed.
+var errPoolClosed = errors.New("grpcclient.Pool: pool is closed")
+
+// Pool shares connections between the users of the same target. A
+// connection is dialed on the first Get for its target, and closed once
+// it hasn't been used for idleTimeout after the last Put.
+// Connections closed by Drain or Close are drained first: they are no
+// longer handed out, and their in-flight RPCs get up to
+// -grpc_pool_drain_timeout to finish.
+// It is safe for concurrent use.
+type Pool struct {
+	idleTimeout time.Duration
+	opts        []grpc.DialOption
+
+	// mu protects conns, draining and closed.
+	mu    sync.Mutex
+	conns map[string]*pooledConn
+	// draining has the connections that were removed from conns by
+	// Drain or Close, and are not closed yet.
+	draining map[*pooledConn]bool
+	closed   bool
+}
+
+// pooledConn is a connection in the pool, with its reference count.
+type pooledConn struct {
+	// ready is closed once cc and err are set.
+	ready chan struct{}
+	cc    *grpc.ClientConn
+	err   error
+	// rpcs counts the in-flight RPCs of cc.
+	rpcs *rpcTracker
+
+	// refs and idleTimer are protected by Pool.mu.
+	refs      int
+	idleTimer *time.Timer
+}
+
+// NewPool creates a pool that dials its connections with opts. Unused
+// connections are closed after idleTimeout, or right away if it is 0.
+// opts must not set interceptors with grpc.WithUnaryInterceptor or
+// grpc.WithStreamInterceptor: they would replace the ones that count the
+// in-flight RPCs for Drain. Use RegisterUnaryClientInterceptor and
+// RegisterStreamClientInterceptor instead.
+func NewPool(idleTimeout time.Duration, opts ...grpc.DialOption) *Pool {
+	return &Pool{
+		idleTimeout: idleTimeout,
+		opts:        opts,
+		conns:       make(map[string]*pooledConn),
+		draining:    make(map[*pooledConn]bool),
+	}
+}
+
+// Get returns a connection to target, dialing it with Dial if there
+// is none yet. Every successful Get must be matched by a Put.
+func (p *Pool) Get(target string) (*grpc.ClientConn, error) {
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil, errPoolClosed
+	}
+	pc, ok := p.conns[target]
+	if ok {
+		pc.refs++
+		if pc.idleTimer != nil {
+			pc.idleTimer.Stop()
+			pc.idleTimer = nil
+		}
+		p.mu.Unlock()
+
+		// Wait for the dial of another Get if it is in progress.
+		<-pc.ready
+		return pc.cc, pc.err
+	}
+
+	pc = &pooledConn{
+		ready: make(chan struct{}),
+		rpcs:  newRPCTracker(),
+		refs:  1,
+	}
+	p.conns[target] = pc
+	p.mu.Unlock()
+
+	// Don't hold the lock while dialing, so that other targets
+	// can be used in the meantime.
+	pc.cc, pc.err = dialContext(context.Background(), target, true, pc.rpcs, p.opts...)
+	if pc.err != nil {
+		p.mu.Lock()
+		if p.conns[target] == pc {
+			delete(p.conns, target)
+		}
+		p.mu.Unlock()
+	}
+	close(pc.ready)
+	return pc.cc, pc.err
+}
+
+// Put returns a connection obtained with Get.
+func (p *Pool) Put(target string, cc *grpc.ClientConn) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	pc, ok := p.conns[target]
+	if !ok || pc.cc != cc {
+		if p.isDraining(cc) {
+			// It is closed once its RPCs are done.
+			return
+		}
+		log.Warningf("grpcclient.Pool: Put of a connection to %v that is not in the pool", target)
+		cc.Close()
+		return
+	}
+	pc.refs--
+	if pc.refs > 0 {
+		return
+	}
+	if p.idleTimeout == 0 {
+		delete(p.conns, target)
+		pc.cc.Close()
+		return
+	}
+	pc.idleTimer = time.AfterFunc(p.idleTimeout, func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		// The connection may have been used again since the timer fired.
+		if p.conns[target] != pc || pc.refs > 0 {
+			return
+		}
+		delete(p.conns, target)
+		pc.cc.Close()
+	})
+}
+
+// Drain removes the connection to target from the pool, so that the
+// next Get dials a new one, for instance when the tablet at target is
+// being removed. It waits for the in-flight RPCs of the connection up
+// to -grpc_pool_drain_timeout and then closes it, even if it is still
+// in use.
+func (p *Pool) Drain(target string) {
+	p.mu.Lock()
+	pc, ok := p.conns[target]
+	if ok {
+		delete(p.conns, target)
+		p.startDrain(pc)
+	}
+	p.mu.Unlock()
+
+	if ok {
+		p.drain(target, pc)
+	}
+}
+
+// Close makes Get fail from now on, and drains all the connections of
+// the pool like Drain, including the ones that are in use. It returns
+// once they are all closed.
+func (p *Pool) Close() {
+	p.mu.Lock()
+	p.closed = true
+	conns := p.conns
+	p.conns = make(map[string]*pooledConn)
+	for _, pc := range conns {
+		p.startDrain(pc)
+	}
+	p.mu.Unlock()
+
+	// The connections are drained in parallel, so that Close doesn't
+	// take longer than the drain timeout.
+	wg := sync.WaitGroup{}
+	for target, pc := range conns {
+		wg.Add(1)
+		go func(target string, pc *pooledConn) {
+			defer wg.Done()
+			p.drain(target, pc)
+		}(target, pc)
+	}
+	wg.Wait()
+}
+
+// startDrain marks pc, which was just removed from conns, as draining.
+// p.mu must be held.
+func (p *Pool) startDrain(pc *pooledConn) {
+	if pc.idleTimer != nil {
+		pc.idleTimer.Stop()
+		pc.idleTimer = nil
+	}
+	p.draining[pc] = true
+}
+
+// isDraining returns true if cc is a connection being drained.
+// p.mu must be held.
+func (p *Pool) isDraining(cc *grpc.ClientConn) bool {
+	for pc := range p.draining {
+		select {
+		case <-pc.ready:
+			if pc.cc == cc {
+				return true
+			}
+		default:
+			// Still dialing, so it's not cc.
+		}
+	}
+	return false
+}
+
+// drain waits for the dial of pc, the connection to target, then for
+// its in-flight RPCs up to -grpc_pool_drain_timeout, and closes it.
+func (p *Pool) drain(target string, pc *pooledConn) {
+	defer func() {
+		p.mu.Lock()
+		delete(p.draining, pc)
+		p.mu.Unlock()
+	}()
+
+	<-pc.ready
+	if pc.cc == nil {
+		return
+	}
+
+	// Connections that are not ready can't complete their RPCs,
+	// so there is no point in waiting for them.
+	switch pc.cc.GetState() {
+	case connectivity.TransientFailure, connectivity.Shutdown:
+	default:
+		if !pc.rpcs.wait(*grpccommon.PoolDrainTimeout) {
+			log.Warningf("grpcclient.Pool: closing connection to %v with %d RPCs still in flight after %v", target, pc.rpcs.count(), *grpccommon.PoolDrainTimeout)
+		}
+	}
+	pc.cc.Close()
+}
+
+// rpcTracker counts the in-flight RPCs of a connection, with
+// interceptors, so that it can be closed once they are done.
+type rpcTracker struct {
+	// mu protects inflight and idle.
+	mu       sync.Mutex
+	inflight int
+	// idle is closed whenever there is no RPC in flight.
+	idle chan struct{}
+}
+
+func newRPCTracker() *rpcTracker {
+	idle := make(chan struct{})
+	close(idle)
+	return &rpcTracker{idle: idle}
+}
+
+func (t *rpcTracker) start() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.inflight == 0 {
+		t.idle = make(chan struct{})
+	}
+	t.inflight++
+}
+
+func (t *rpcTracker) finish() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.inflight--
+	if t.inflight == 0 {
+		close(t.idle)
+	}
+}
+
+func (t *rpcTracker) count() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.inflight
+}
+
+// wait waits until no RPC is in flight, and returns false if that
+// didn't happen within timeout.
+func (t *rpcTracker) wait(timeout time.Duration) bool {
+	t.mu.Lock()
+	idle := t.idle
+	t.mu.Unlock()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-idle:
+		return true
+	case <-timer.C:
+		return false
+	}
+}
+
+func (t *rpcTracker) unaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	t.start()
+	defer t.finish()
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+func (t *rpcTracker) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	t.start()
+	stream, err := streamer(ctx, desc, cc, method, opts...)
+	if err != nil {
+		t.finish()
+		return nil, err
+	}
+	s := &trackedStream{
+		ClientStream:  stream,
+		serverStreams: desc.ServerStreams,
+		tracker:       t,
+		done:          make(chan struct{}),
+	}
+	// A stream that the caller abandons by canceling ctx also ends,
+	// even if RecvMsg is not called again.
+	go func() {
+		select {
+		case <-ctx.Done():
+			s.finish()
+		case <-s.done:
+		}
+	}()
+	return s, nil
+}
+
+// trackedStream is a stream counted by an rpcTracker until it ends.
+type trackedStream struct {
+	grpc.ClientStream
+	serverStreams bool
+	tracker       *rpcTracker
+	once          sync.Once
+	// done is closed once the stream ended.
+	done chan struct{}
+}
+
+// RecvMsg is part of the grpc.ClientStream interface. A stream ends
+// when RecvMsg fails, which is io.EOF once the server is done, or after
+// the single reply of a stream whose server doesn't stream.
+func (s *trackedStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil || !s.serverStreams {
+		s.finish()
+	}
+	return err
+}
+
+// finish counts the stream as ended, the first time it is called.
+func (s *trackedStream) finish() {
+	s.once.Do(func() {
+		close(s.done)
+		s.tracker.finish()
+	})
+}
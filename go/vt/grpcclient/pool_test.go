@@ -0,0 +1,276 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+
+	"github.com/youtube/vitess/go/vt/grpccommon"
+)
+
+// pooledConnOf returns the connection of the pool to target, or nil.
+func pooledConnOf(p *Pool, target string) *pooledConn {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.conns[target]
+}
+
+func TestPool(t *testing.T) {
+	target, stop := startTestServer(t)
+	defer stop()
+
+	p := NewPool(100*time.Millisecond, grpc.WithInsecure())
+	defer p.Close()
+
+	// Concurrent users of the same target share a connection.
+	ccs := make([]*grpc.ClientConn, 5)
+	wg := sync.WaitGroup{}
+	for i := range ccs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cc, err := p.Get(target)
+			if err != nil {
+				t.Errorf("Get failed: %v", err)
+			}
+			ccs[i] = cc
+		}(i)
+	}
+	wg.Wait()
+	for _, cc := range ccs[1:] {
+		if cc != ccs[0] {
+			t.Fatalf("Get returned different connections for the same target")
+		}
+	}
+	for _, cc := range ccs {
+		p.Put(target, cc)
+	}
+
+	// Before the idle timeout, the connection is reused.
+	cc, err := p.Get(target)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if cc != ccs[0] {
+		t.Errorf("Get returned a new connection before the idle timeout")
+	}
+	p.Put(target, cc)
+
+	// After the idle timeout, it is closed and a new one is dialed.
+	time.Sleep(200 * time.Millisecond)
+	cc, err = p.Get(target)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if cc == ccs[0] {
+		t.Errorf("Get returned the same connection after the idle timeout")
+	}
+	p.Put(target, cc)
+
+	// Dial errors are returned, and not cached.
+	if _, err := p.Get("badhost"); err == nil {
+		t.Errorf("Get(badhost) succeeded, want error")
+	}
+	if pc := pooledConnOf(p, "badhost"); pc != nil {
+		t.Errorf("failed connection to badhost is still in the pool")
+	}
+}
+
+func TestPoolDrain(t *testing.T) {
+	defer func(timeout time.Duration) {
+		*grpccommon.PoolDrainTimeout = timeout
+	}(*grpccommon.PoolDrainTimeout)
+
+	target, stop := startTestServer(t)
+	defer stop()
+
+	p := NewPool(time.Minute, grpc.WithInsecure())
+
+	// Drain waits for the in-flight RPCs before closing the connection.
+	*grpccommon.PoolDrainTimeout = 5 * time.Second
+	cc, err := p.Get(target)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	rpcs := pooledConnOf(p, target).rpcs
+	rpcs.start()
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		if state := cc.GetState(); state == connectivity.Shutdown {
+			t.Errorf("connection closed while an RPC is in flight")
+		}
+		rpcs.finish()
+	}()
+	start := time.Now()
+	p.Drain(target)
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("Drain returned after %v, before the RPC finished", elapsed)
+	}
+	if state := cc.GetState(); state != connectivity.Shutdown {
+		t.Errorf("connection state after Drain: %v, want %v", state, connectivity.Shutdown)
+	}
+	// Putting back a drained connection is fine, and the next Get
+	// dials a new one.
+	p.Put(target, cc)
+	cc2, err := p.Get(target)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if cc2 == cc {
+		t.Errorf("Get returned a drained connection")
+	}
+
+	// After the drain timeout, Close closes connections that still
+	// have RPCs in flight.
+	*grpccommon.PoolDrainTimeout = 10 * time.Millisecond
+	pooledConnOf(p, target).rpcs.start()
+	p.Close()
+	if state := cc2.GetState(); state != connectivity.Shutdown {
+		t.Errorf("connection state after Close: %v, want %v", state, connectivity.Shutdown)
+	}
+	p.Put(target, cc2)
+
+	if _, err := p.Get(target); err != errPoolClosed {
+		t.Errorf("Get after Close: %v, want %v", err, errPoolClosed)
+	}
+}
+
+func TestPoolDrainRegisteredInterceptor(t *testing.T) {
+	target, stop := startTestServer(t)
+	defer stop()
+
+	// The registered interceptors are chained after the one that
+	// counts the RPCs, so Drain still waits for them.
+	called := make(chan struct{})
+	release := make(chan struct{})
+	RegisterUnaryClientInterceptor(func(string) grpc.UnaryClientInterceptor {
+		return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			close(called)
+			<-release
+			return nil
+		}
+	})
+	defer func() {
+		unaryFactories = nil
+	}()
+	p := NewPool(time.Minute, grpc.WithInsecure())
+	defer p.Close()
+
+	cc, err := p.Get(target)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	go grpc.Invoke(context.Background(), "/test/Method", nil, nil, cc)
+	<-called
+	if n := pooledConnOf(p, target).rpcs.count(); n != 1 {
+		t.Errorf("in-flight RPCs: %v, want 1", n)
+	}
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+	}()
+	start := time.Now()
+	p.Drain(target)
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("Drain returned after %v, before the RPC finished", elapsed)
+	}
+}
+
+// fakeClientStream is a grpc.ClientStream that replies n messages.
+type fakeClientStream struct {
+	grpc.ClientStream
+	n int
+}
+
+func (s *fakeClientStream) RecvMsg(m interface{}) error {
+	if s.n == 0 {
+		return io.EOF
+	}
+	s.n--
+	return nil
+}
+
+func TestRPCTracker(t *testing.T) {
+	tracker := newRPCTracker()
+	if !tracker.wait(time.Second) {
+		t.Errorf("wait without RPCs: false, want true")
+	}
+
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		if got := tracker.count(); got != 1 {
+			t.Errorf("in-flight RPCs during a unary RPC: %v, want 1", got)
+		}
+		return nil
+	}
+	if err := tracker.unaryInterceptor(context.Background(), "/test/Method", nil, nil, nil, invoker); err != nil {
+		t.Fatalf("unaryInterceptor failed: %v", err)
+	}
+	if got := tracker.count(); got != 0 {
+		t.Errorf("in-flight RPCs after a unary RPC: %v, want 0", got)
+	}
+
+	for _, serverStreams := range []bool{true, false} {
+		streamer := func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+			return &fakeClientStream{n: 2}, nil
+		}
+		stream, err := tracker.streamInterceptor(context.Background(), &grpc.StreamDesc{ServerStreams: serverStreams}, nil, "/test/Stream", streamer)
+		if err != nil {
+			t.Fatalf("streamInterceptor failed: %v", err)
+		}
+		if tracker.wait(10 * time.Millisecond) {
+			t.Errorf("wait with a stream in flight: true, want false")
+		}
+		stream.RecvMsg(nil)
+		want := 1
+		if !serverStreams {
+			// The single reply ends the stream.
+			want = 0
+		}
+		if got := tracker.count(); got != want {
+			t.Errorf("in-flight RPCs after a reply with ServerStreams=%v: %v, want %v", serverStreams, got, want)
+		}
+		for i := 0; i < 3; i++ {
+			stream.RecvMsg(nil)
+		}
+		if got := tracker.count(); got != 0 {
+			t.Errorf("in-flight RPCs after the end of a stream with ServerStreams=%v: %v, want 0", serverStreams, got)
+		}
+	}
+
+	// Canceling the context of a stream ends it, even without RecvMsg.
+	streamer := func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return &fakeClientStream{n: 2}, nil
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	if _, err := tracker.streamInterceptor(ctx, &grpc.StreamDesc{ServerStreams: true}, nil, "/test/Stream", streamer); err != nil {
+		t.Fatalf("streamInterceptor failed: %v", err)
+	}
+	if got := tracker.count(); got != 1 {
+		t.Errorf("in-flight RPCs with a stream in flight: %v, want 1", got)
+	}
+	cancel()
+	if !tracker.wait(time.Second) {
+		t.Errorf("wait after canceling the stream: false, want true")
+	}
+}
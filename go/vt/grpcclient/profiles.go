@@ -0,0 +1,67 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"fmt"
+	"sync"
+
+	log "github.com/golang/glog"
+	"google.golang.org/grpc"
+)
+
+// Profile returns the dial options for a kind of connection, for instance
+// the ones from vtgate to the vttablets. It is called for every dial, so
+// that the options can depend on flags.
+type Profile func() ([]grpc.DialOption, error)
+
+var (
+	profilesMu sync.Mutex
+	profiles   = make(map[string]Profile)
+)
+
+// RegisterProfile registers the named profile, usually from the init()
+// of the package of the client that uses it.
+func RegisterProfile(name string, profile Profile) {
+	profilesMu.Lock()
+	defer profilesMu.Unlock()
+	if _, ok := profiles[name]; ok {
+		log.Fatalf("grpc dial profile %s already exists", name)
+	}
+	profiles[name] = profile
+}
+
+// ProfileDialOptions returns the dial options of the named profile.
+func ProfileDialOptions(name string) ([]grpc.DialOption, error) {
+	profilesMu.Lock()
+	profile, ok := profiles[name]
+	profilesMu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown grpc dial profile %s", name)
+	}
+	return profile()
+}
+
+// DialProfile is like Dial with the options of the named profile, which
+// are applied before opts so that the caller can override them.
+func DialProfile(target, profile string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	profileOpts, err := ProfileDialOptions(profile)
+	if err != nil {
+		return nil, err
+	}
+	return Dial(target, append(profileOpts, opts...)...)
+}
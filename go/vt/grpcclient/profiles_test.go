@@ -0,0 +1,60 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+)
+
+func TestProfiles(t *testing.T) {
+	addr, stop := startTestServer(t)
+	defer stop()
+
+	calls := 0
+	RegisterProfile("test_insecure", func() ([]grpc.DialOption, error) {
+		calls++
+		return []grpc.DialOption{grpc.WithInsecure()}, nil
+	})
+	RegisterProfile("test_error", func() ([]grpc.DialOption, error) {
+		return nil, errors.New("bad flags")
+	})
+
+	opts, err := ProfileDialOptions("test_insecure")
+	if err != nil || len(opts) != 1 {
+		t.Errorf("ProfileDialOptions(test_insecure): %v %v, want one option", opts, err)
+	}
+
+	// Without the profile, Dial fails as there are no transport options.
+	cc, err := DialProfile(addr, "test_insecure")
+	if err != nil {
+		t.Fatalf("DialProfile(test_insecure) failed: %v", err)
+	}
+	cc.Close()
+	if calls != 2 {
+		t.Errorf("test_insecure profile called %v times, want 2", calls)
+	}
+
+	if _, err := DialProfile(addr, "test_error"); err == nil || err.Error() != "bad flags" {
+		t.Errorf("DialProfile(test_error): %v, want bad flags", err)
+	}
+	if _, err := ProfileDialOptions("unknown"); err == nil {
+		t.Errorf("ProfileDialOptions(unknown): expected error")
+	}
+}
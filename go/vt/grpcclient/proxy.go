@@ -0,0 +1,258 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// ProxyDialOption returns the dial option to connect through the forward
+// proxy at proxyURL, or through the one of the HTTPS_PROXY environment
+// variable if proxyURL is empty. http:// proxies (the default if there is
+// no scheme) are asked to CONNECT to the target, and socks5:// proxies
+// use the SOCKS5 protocol. The user and password of the URL, if any, are
+// sent to the proxy. TLS, if set up, is negotiated with the target
+// through the tunnel.
+func ProxyDialOption(proxyURL string) (grpc.DialOption, error) {
+	if proxyURL == "" {
+		proxyURL = os.Getenv("HTTPS_PROXY")
+	}
+	if proxyURL == "" {
+		proxyURL = os.Getenv("https_proxy")
+	}
+	if proxyURL == "" {
+		return nil, fmt.Errorf("no proxy given and HTTPS_PROXY is not set")
+	}
+	if !strings.Contains(proxyURL, "://") {
+		proxyURL = "http://" + proxyURL
+	}
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy %v: %v", proxyURL, err)
+	}
+
+	var connect func(conn net.Conn, u *url.URL, addr string) (net.Conn, error)
+	var defaultPort string
+	switch u.Scheme {
+	case "http":
+		connect, defaultPort = httpConnect, "80"
+	case "socks5":
+		connect, defaultPort = socks5Connect, "1080"
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme %q", u.Scheme)
+	}
+	proxyAddr := u.Host
+	if u.Port() == "" {
+		proxyAddr = net.JoinHostPort(u.Hostname(), defaultPort)
+	}
+
+	return grpc.WithDialer(func(addr string, timeout time.Duration) (net.Conn, error) {
+		conn, err := net.DialTimeout("tcp", proxyAddr, timeout)
+		if err != nil {
+			return nil, err
+		}
+		if timeout > 0 {
+			conn.SetDeadline(time.Now().Add(timeout))
+		}
+		tunnel, err := connect(conn, u, addr)
+		if err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("proxy %v failed to connect to %v: %v", proxyAddr, addr, err)
+		}
+		conn.SetDeadline(time.Time{})
+		return tunnel, nil
+	}), nil
+}
+
+// bufConn is a connection whose first bytes were already read into a
+// buffer, which it returns before reading from the connection again.
+type bufConn struct {
+	net.Conn
+	r io.Reader
+}
+
+// Read is part of the net.Conn interface.
+func (c *bufConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
+// httpConnect opens a tunnel to addr with an HTTP CONNECT request, and
+// returns the connection to use for it.
+func httpConnect(conn net.Conn, u *url.URL, addr string) (net.Conn, error) {
+	req := &http.Request{
+		Method: "CONNECT",
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: make(http.Header),
+	}
+	if u.User != nil {
+		password, _ := u.User.Password()
+		auth := base64.StdEncoding.EncodeToString([]byte(u.User.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+auth)
+	}
+	if err := req.Write(conn); err != nil {
+		return nil, err
+	}
+
+	r := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(r, req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("CONNECT: %v", resp.Status)
+	}
+	// The server sends its settings as soon as the tunnel is open, so
+	// the reader may already have buffered them with the response.
+	if r.Buffered() > 0 {
+		return &bufConn{Conn: conn, r: r}, nil
+	}
+	return conn, nil
+}
+
+// SOCKS5 constants, see RFC 1928 and RFC 1929.
+const (
+	socks5Version        = 5
+	socks5NoAuth         = 0
+	socks5UserPass       = 2
+	socks5NoAcceptable   = 0xff
+	socks5CmdConnect     = 1
+	socks5AddrIPv4       = 1
+	socks5AddrDomain     = 3
+	socks5AddrIPv6       = 4
+	socks5UserPassStatus = 1
+)
+
+// socks5Connect opens a tunnel to addr with the SOCKS5 protocol, and
+// returns the connection to use for it.
+func socks5Connect(conn net.Conn, u *url.URL, addr string) (net.Conn, error) {
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port < 0 || port > 0xffff {
+		return nil, fmt.Errorf("invalid port %q", portStr)
+	}
+
+	methods := []byte{socks5NoAuth}
+	if u.User != nil {
+		methods = append(methods, socks5UserPass)
+	}
+	if _, err := conn.Write(append([]byte{socks5Version, byte(len(methods))}, methods...)); err != nil {
+		return nil, err
+	}
+	reply := make([]byte, 2)
+	if _, err := io.ReadFull(conn, reply); err != nil {
+		return nil, err
+	}
+	if reply[0] != socks5Version {
+		return nil, fmt.Errorf("unexpected SOCKS version %v", reply[0])
+	}
+	switch reply[1] {
+	case socks5NoAuth:
+	case socks5UserPass:
+		if u.User == nil {
+			return nil, fmt.Errorf("SOCKS proxy requires a user and password")
+		}
+		user := u.User.Username()
+		password, _ := u.User.Password()
+		if len(user) > 255 || len(password) > 255 {
+			return nil, fmt.Errorf("SOCKS user or password too long")
+		}
+		auth := []byte{socks5UserPassStatus, byte(len(user))}
+		auth = append(auth, user...)
+		auth = append(auth, byte(len(password)))
+		auth = append(auth, password...)
+		if _, err := conn.Write(auth); err != nil {
+			return nil, err
+		}
+		if _, err := io.ReadFull(conn, reply); err != nil {
+			return nil, err
+		}
+		if reply[1] != 0 {
+			return nil, fmt.Errorf("SOCKS authentication failed")
+		}
+	case socks5NoAcceptable:
+		return nil, fmt.Errorf("no acceptable SOCKS authentication method")
+	default:
+		return nil, fmt.Errorf("unexpected SOCKS authentication method %v", reply[1])
+	}
+
+	req := []byte{socks5Version, socks5CmdConnect, 0}
+	if ip := net.ParseIP(host); ip == nil {
+		if len(host) > 255 {
+			return nil, fmt.Errorf("host name too long: %v", host)
+		}
+		req = append(req, socks5AddrDomain, byte(len(host)))
+		req = append(req, host...)
+	} else if ip4 := ip.To4(); ip4 != nil {
+		req = append(req, socks5AddrIPv4)
+		req = append(req, ip4...)
+	} else {
+		req = append(req, socks5AddrIPv6)
+		req = append(req, ip.To16()...)
+	}
+	req = append(req, 0, 0)
+	binary.BigEndian.PutUint16(req[len(req)-2:], uint16(port))
+	if _, err := conn.Write(req); err != nil {
+		return nil, err
+	}
+
+	// The reply has the version, status, a reserved byte and the
+	// bound address, which we read and discard.
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return nil, err
+	}
+	if header[1] != 0 {
+		return nil, fmt.Errorf("SOCKS connect failed with status %v", header[1])
+	}
+	var addrLen int
+	switch header[3] {
+	case socks5AddrIPv4:
+		addrLen = net.IPv4len
+	case socks5AddrIPv6:
+		addrLen = net.IPv6len
+	case socks5AddrDomain:
+		if _, err := io.ReadFull(conn, header[:1]); err != nil {
+			return nil, err
+		}
+		addrLen = int(header[0])
+	default:
+		return nil, fmt.Errorf("unexpected SOCKS address type %v", header[3])
+	}
+	if _, err := io.ReadFull(conn, make([]byte, addrLen+2)); err != nil {
+		return nil, err
+	}
+	// The replies are read exactly, so nothing of the tunnel is lost.
+	return conn, nil
+}
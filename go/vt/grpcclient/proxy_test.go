@@ -0,0 +1,189 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"testing"
+
+	"google.golang.org/grpc"
+)
+
+// fakeProxy accepts connections on a local port, opens a tunnel to the
+// address returned by handshake, and sends that address to targets.
+type fakeProxy struct {
+	listener net.Listener
+	targets  chan string
+}
+
+func newFakeProxy(t *testing.T, handshake func(r *bufio.Reader, w io.Writer) (string, error)) *fakeProxy {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	p := &fakeProxy{
+		listener: listener,
+		targets:  make(chan string, 10),
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				r := bufio.NewReader(conn)
+				target, err := handshake(r, conn)
+				if err != nil {
+					return
+				}
+				p.targets <- target
+				backend, err := net.Dial("tcp", target)
+				if err != nil {
+					return
+				}
+				defer backend.Close()
+				go io.Copy(backend, r)
+				io.Copy(conn, backend)
+			}()
+		}
+	}()
+	return p
+}
+
+// httpHandshake answers an HTTP CONNECT request. It writes the response
+// before the tunnel is open, so the response and the first bytes of the
+// server arrive separately.
+func httpHandshake(r *bufio.Reader, w io.Writer) (string, error) {
+	req, err := http.ReadRequest(r)
+	if err != nil {
+		return "", err
+	}
+	if req.Method != "CONNECT" {
+		io.WriteString(w, "HTTP/1.1 405 Method Not Allowed\r\n\r\n")
+		return "", io.EOF
+	}
+	io.WriteString(w, "HTTP/1.1 200 OK\r\n\r\n")
+	return req.Host, nil
+}
+
+// socks5Handshake answers a SOCKS5 connect request without
+// authentication, for an IPv4 address.
+func socks5Handshake(r *bufio.Reader, w io.Writer) (string, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return "", err
+	}
+	if _, err := io.ReadFull(r, make([]byte, header[1])); err != nil {
+		return "", err
+	}
+	w.Write([]byte{socks5Version, socks5NoAuth})
+
+	req := make([]byte, 4+net.IPv4len+2)
+	if _, err := io.ReadFull(r, req); err != nil {
+		return "", err
+	}
+	if req[1] != socks5CmdConnect || req[3] != socks5AddrIPv4 {
+		return "", io.EOF
+	}
+	ip := net.IP(req[4 : 4+net.IPv4len])
+	port := binary.BigEndian.Uint16(req[4+net.IPv4len:])
+	w.Write([]byte{socks5Version, 0, 0, socks5AddrIPv4, 0, 0, 0, 0, 0, 0})
+	return net.JoinHostPort(ip.String(), strconv.Itoa(int(port))), nil
+}
+
+func TestProxyDialOption(t *testing.T) {
+	addr, stop := startTestServer(t)
+	defer stop()
+
+	for _, tcase := range []struct {
+		scheme    string
+		handshake func(r *bufio.Reader, w io.Writer) (string, error)
+	}{
+		{"http", httpHandshake},
+		{"socks5", socks5Handshake},
+	} {
+		proxy := newFakeProxy(t, tcase.handshake)
+		defer proxy.listener.Close()
+
+		opt, err := ProxyDialOption(tcase.scheme + "://" + proxy.listener.Addr().String())
+		if err != nil {
+			t.Fatalf("ProxyDialOption(%v) failed: %v", tcase.scheme, err)
+		}
+		cc, err := Dial(addr, grpc.WithInsecure(), opt)
+		if err != nil {
+			t.Fatalf("Dial through %v proxy failed: %v", tcase.scheme, err)
+		}
+		cc.Close()
+		if target := <-proxy.targets; target != addr {
+			t.Errorf("%v proxy connected to %v, want %v", tcase.scheme, target, addr)
+		}
+	}
+}
+
+func TestHTTPConnectBufferedData(t *testing.T) {
+	client, proxy := net.Pipe()
+	defer client.Close()
+	go func() {
+		defer proxy.Close()
+		if _, err := http.ReadRequest(bufio.NewReader(proxy)); err != nil {
+			return
+		}
+		// The first bytes of the server arrive with the response.
+		io.WriteString(proxy, "HTTP/1.1 200 OK\r\n\r\nSETTINGS")
+	}()
+
+	conn, err := httpConnect(client, &url.URL{Host: "proxy:3128"}, "localhost:1")
+	if err != nil {
+		t.Fatalf("httpConnect failed: %v", err)
+	}
+	data, err := ioutil.ReadAll(conn)
+	if err != nil {
+		t.Fatalf("ReadAll failed: %v", err)
+	}
+	if got, want := string(data), "SETTINGS"; got != want {
+		t.Errorf("tunnel data: %q, want %q", got, want)
+	}
+}
+
+func TestProxyDialOptionErrors(t *testing.T) {
+	defer os.Setenv("HTTPS_PROXY", os.Getenv("HTTPS_PROXY"))
+	defer os.Setenv("https_proxy", os.Getenv("https_proxy"))
+	os.Unsetenv("HTTPS_PROXY")
+	os.Unsetenv("https_proxy")
+
+	if _, err := ProxyDialOption(""); err == nil {
+		t.Errorf("ProxyDialOption without HTTPS_PROXY: expected error")
+	}
+	if _, err := ProxyDialOption("ftp://proxy:21"); err == nil {
+		t.Errorf("ProxyDialOption(ftp://proxy:21): expected error")
+	}
+
+	os.Setenv("HTTPS_PROXY", "proxy:3128")
+	if _, err := ProxyDialOption(""); err != nil {
+		t.Errorf("ProxyDialOption with HTTPS_PROXY failed: %v", err)
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"fmt"
+	"net"
+	"sync"
+
+	log "github.com/golang/glog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/youtube/vitess/go/vt/vttls"
+)
+
+// ReloadingSecureDialOption is like SecureDialOption, except that the
+// cert, key and ca files are read again for every new connection, so that
+// rotated certificates are picked up without restarting the process. If
+// the files can't be loaded, for instance while they are being replaced,
+// the last valid ones are used.
+func ReloadingSecureDialOption(cert, key, ca, name string) (grpc.DialOption, error) {
+	// No security options set, just return.
+	if (cert == "" || key == "") && ca == "" {
+		return grpc.WithInsecure(), nil
+	}
+
+	creds, err := newReloadingCreds(cert, key, ca, name)
+	if err != nil {
+		return nil, err
+	}
+	return grpc.WithTransportCredentials(creds), nil
+}
+
+// reloadingCreds are client TLS transport credentials that load their
+// config from the files for each handshake.
+type reloadingCreds struct {
+	cert, key, ca string
+
+	mu   sync.Mutex
+	name string
+	// last is the credentials from the last config that was loaded.
+	last credentials.TransportCredentials
+}
+
+func newReloadingCreds(cert, key, ca, name string) (*reloadingCreds, error) {
+	c := &reloadingCreds{
+		cert: cert,
+		key:  key,
+		ca:   ca,
+		name: name,
+	}
+	// Fail right away if the files can't be loaded at all.
+	if _, err := c.reload(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// reload reads the files and returns the resulting credentials, or the
+// last valid ones if they can't be loaded.
+func (c *reloadingCreds) reload() (credentials.TransportCredentials, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	config, err := vttls.ClientConfig(c.cert, c.key, c.ca, c.name)
+	if err == nil {
+		err = setTLSMinVersion(config)
+	}
+	if err != nil {
+		if c.last == nil {
+			return nil, err
+		}
+		log.Warningf("Failed to reload TLS config, using the previous one: %v", err)
+		return c.last, nil
+	}
+	c.last = credentials.NewTLS(config)
+	return c.last, nil
+}
+
+// ClientHandshake is part of the credentials.TransportCredentials interface.
+func (c *reloadingCreds) ClientHandshake(ctx context.Context, authority string, rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	creds, err := c.reload()
+	if err != nil {
+		return nil, nil, err
+	}
+	return creds.ClientHandshake(ctx, authority, rawConn)
+}
+
+// ServerHandshake is part of the credentials.TransportCredentials interface.
+func (c *reloadingCreds) ServerHandshake(rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return nil, nil, fmt.Errorf("reloading credentials can only be used by clients")
+}
+
+// Info is part of the credentials.TransportCredentials interface.
+func (c *reloadingCreds) Info() credentials.ProtocolInfo {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.last.Info()
+}
+
+// Clone is part of the credentials.TransportCredentials interface.
+func (c *reloadingCreds) Clone() credentials.TransportCredentials {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return &reloadingCreds{
+		cert: c.cert,
+		key:  c.key,
+		ca:   c.ca,
+		name: c.name,
+		last: c.last.Clone(),
+	}
+}
+
+// OverrideServerName is part of the credentials.TransportCredentials interface.
+func (c *reloadingCreds) OverrideServerName(serverNameOverride string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.name = serverNameOverride
+	return c.last.OverrideServerName(serverNameOverride)
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"crypto/tls"
+	"io/ioutil"
+	"net"
+	"os"
+	"path"
+	"testing"
+
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/vt/tlstest"
+	"github.com/youtube/vitess/go/vt/vttls"
+)
+
+func copyFile(t *testing.T, from, to string) {
+	data, err := ioutil.ReadFile(from)
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	if err := ioutil.WriteFile(to, data, 0600); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+}
+
+func TestReloadingCreds(t *testing.T) {
+	root, err := ioutil.TempDir("", "grpcclient")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	tlstest.CreateCA(root)
+	tlstest.CreateSignedCert(root, tlstest.CA, "01", "servers", "Servers CA")
+	tlstest.CreateSignedCert(root, "servers", "01", "server-instance", "Server Instance")
+	tlstest.CreateSignedCert(root, tlstest.CA, "02", "clients", "Clients CA")
+	tlstest.CreateSignedCert(root, "clients", "01", "client-instance", "Client Instance")
+
+	serverConfig, err := vttls.ServerConfig(
+		path.Join(root, "server-instance-cert.pem"),
+		path.Join(root, "server-instance-key.pem"),
+		path.Join(root, "clients-cert.pem"))
+	if err != nil {
+		t.Fatalf("ServerConfig failed: %v", err)
+	}
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	defer listener.Close()
+
+	// The client uses files that are rotated below.
+	cert := path.Join(root, "client-cert.pem")
+	key := path.Join(root, "client-key.pem")
+	copyFile(t, path.Join(root, "client-instance-cert.pem"), cert)
+	copyFile(t, path.Join(root, "client-instance-key.pem"), key)
+
+	creds, err := newReloadingCreds(cert, key, path.Join(root, "servers-cert.pem"), "Server Instance")
+	if err != nil {
+		t.Fatalf("newReloadingCreds failed: %v", err)
+	}
+
+	// handshake connects a client, and returns the result of the
+	// handshake as seen by the server.
+	handshake := func() error {
+		done := make(chan error, 1)
+		go func() {
+			conn, err := listener.Accept()
+			if err != nil {
+				done <- err
+				return
+			}
+			defer conn.Close()
+			done <- conn.(*tls.Conn).Handshake()
+		}()
+		rawConn, err := net.Dial("tcp", listener.Addr().String())
+		if err != nil {
+			t.Fatalf("Dial failed: %v", err)
+		}
+		defer rawConn.Close()
+		if conn, _, err := creds.ClientHandshake(context.Background(), "", rawConn); err == nil {
+			// Make sure the server gets to check the client cert.
+			conn.Write([]byte{42})
+		}
+		return <-done
+	}
+
+	if err := handshake(); err != nil {
+		t.Errorf("handshake with the initial cert failed: %v", err)
+	}
+
+	// Files that can't be loaded are ignored.
+	if err := os.Remove(cert); err != nil {
+		t.Fatalf("Remove failed: %v", err)
+	}
+	if err := handshake(); err != nil {
+		t.Errorf("handshake with a missing cert failed: %v", err)
+	}
+
+	// A rotated cert is picked up on the next handshake. The server
+	// cert isn't signed by the clients CA, so it is rejected.
+	copyFile(t, path.Join(root, "server-instance-cert.pem"), cert)
+	copyFile(t, path.Join(root, "server-instance-key.pem"), key)
+	if err := handshake(); err == nil {
+		t.Errorf("handshake with the rotated cert succeeded, want the server to reject it")
+	}
+}
+
+func TestReloadingSecureDialOption(t *testing.T) {
+	if _, err := ReloadingSecureDialOption("", "", "", ""); err != nil {
+		t.Errorf("ReloadingSecureDialOption without files failed: %v", err)
+	}
+	if _, err := ReloadingSecureDialOption("/nonexistent/cert.pem", "/nonexistent/key.pem", "", ""); err == nil {
+		t.Errorf("ReloadingSecureDialOption with missing files succeeded, want error")
+	}
+}
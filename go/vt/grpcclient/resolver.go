@@ -0,0 +1,126 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"time"
+
+	log "github.com/golang/glog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/resolver"
+)
+
+// AddressLister returns the current host:port addresses for the
+// endpoint of a target, for instance "keyspace/shard" for the target
+// "vitess:///keyspace/shard".
+type AddressLister func(ctx context.Context, endpoint string) ([]string, error)
+
+// RegisterResolver makes Dial resolve the targets of the given scheme
+// with lister, which is called again every refreshInterval and whenever
+// grpc asks for it, for instance after a connection failure. It must be
+// called from init(), as the grpc resolvers can't be changed later.
+func RegisterResolver(scheme string, lister AddressLister, refreshInterval time.Duration) {
+	resolver.Register(&listerBuilder{
+		scheme:          scheme,
+		lister:          lister,
+		refreshInterval: refreshInterval,
+	})
+}
+
+// listerBuilder implements resolver.Builder with an AddressLister.
+type listerBuilder struct {
+	scheme          string
+	lister          AddressLister
+	refreshInterval time.Duration
+}
+
+// Build is part of the resolver.Builder interface.
+func (b *listerBuilder) Build(target resolver.Target, cc resolver.ClientConn, opts resolver.BuildOption) (resolver.Resolver, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &listerResolver{
+		builder:  b,
+		endpoint: target.Endpoint,
+		cc:       cc,
+		cancel:   cancel,
+		resolve:  make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+	go r.run(ctx)
+	return r, nil
+}
+
+// Scheme is part of the resolver.Builder interface.
+func (b *listerBuilder) Scheme() string {
+	return b.scheme
+}
+
+// listerResolver implements resolver.Resolver for a target.
+type listerResolver struct {
+	builder  *listerBuilder
+	endpoint string
+	cc       resolver.ClientConn
+	cancel   context.CancelFunc
+	// resolve is signaled by ResolveNow.
+	resolve chan struct{}
+	done    chan struct{}
+}
+
+func (r *listerResolver) run(ctx context.Context) {
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.builder.refreshInterval)
+	defer ticker.Stop()
+	for {
+		r.update(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.resolve:
+		}
+	}
+}
+
+// update lists the addresses and sends them to grpc. On errors, the
+// previous addresses are kept.
+func (r *listerResolver) update(ctx context.Context) {
+	addrs, err := r.builder.lister(ctx, r.endpoint)
+	if err != nil {
+		log.Warningf("Failed to resolve %v://%v: %v", r.builder.scheme, r.endpoint, err)
+		return
+	}
+	result := make([]resolver.Address, 0, len(addrs))
+	for _, addr := range addrs {
+		result = append(result, resolver.Address{Addr: addr})
+	}
+	r.cc.NewAddress(result)
+}
+
+// ResolveNow is part of the resolver.Resolver interface.
+func (r *listerResolver) ResolveNow(opts resolver.ResolveNowOption) {
+	select {
+	case r.resolve <- struct{}{}:
+	default:
+		// A resolution is already pending.
+	}
+}
+
+// Close is part of the resolver.Resolver interface.
+func (r *listerResolver) Close() {
+	r.cancel()
+	<-r.done
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/resolver"
+)
+
+// fakeClientConn implements resolver.ClientConn, and sends the
+// addresses it gets to a channel.
+type fakeClientConn struct {
+	addrs chan []resolver.Address
+}
+
+func (cc *fakeClientConn) NewAddress(addrs []resolver.Address) {
+	cc.addrs <- addrs
+}
+
+func (cc *fakeClientConn) NewServiceConfig(serviceConfig string) {}
+
+func TestListerResolver(t *testing.T) {
+	mu := sync.Mutex{}
+	tablets := map[string][]string{
+		"ks/0": {"host1:1", "host2:2"},
+	}
+	lister := func(ctx context.Context, endpoint string) ([]string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		addrs, ok := tablets[endpoint]
+		if !ok {
+			return nil, fmt.Errorf("unknown shard %v", endpoint)
+		}
+		return addrs, nil
+	}
+
+	b := &listerBuilder{
+		scheme:          "vitess",
+		lister:          lister,
+		refreshInterval: time.Hour,
+	}
+	if got, want := b.Scheme(), "vitess"; got != want {
+		t.Errorf("Scheme: %v, want %v", got, want)
+	}
+	cc := &fakeClientConn{addrs: make(chan []resolver.Address, 10)}
+	r, err := b.Build(resolver.Target{Scheme: "vitess", Endpoint: "ks/0"}, cc, resolver.BuildOption{})
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	defer r.Close()
+
+	want := []resolver.Address{{Addr: "host1:1"}, {Addr: "host2:2"}}
+	if got := <-cc.addrs; !reflect.DeepEqual(got, want) {
+		t.Errorf("initial addresses: %v, want %v", got, want)
+	}
+
+	// ResolveNow picks up changes without waiting for the refresh.
+	mu.Lock()
+	tablets["ks/0"] = []string{"host3:3"}
+	mu.Unlock()
+	r.ResolveNow(resolver.ResolveNowOption{})
+	want = []resolver.Address{{Addr: "host3:3"}}
+	select {
+	case got := <-cc.addrs:
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("addresses after ResolveNow: %v, want %v", got, want)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no addresses after ResolveNow")
+	}
+
+	// Errors keep the previous addresses.
+	mu.Lock()
+	delete(tablets, "ks/0")
+	mu.Unlock()
+	r.ResolveNow(resolver.ResolveNowOption{})
+	select {
+	case got := <-cc.addrs:
+		t.Errorf("addresses after a failed resolution: %v, want none", got)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
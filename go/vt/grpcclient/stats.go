@@ -0,0 +1,78 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"golang.org/x/net/context"
+	grpcstats "google.golang.org/grpc/stats"
+
+	"github.com/youtube/vitess/go/stats"
+)
+
+// The client stats, by target, exported when -grpc_client_stats is set.
+var (
+	clientConnections   = stats.NewCounters("GrpcClientConnections")
+	clientRPCsStarted   = stats.NewCounters("GrpcClientRPCsStarted")
+	clientRPCsCompleted = stats.NewCounters("GrpcClientRPCsCompleted")
+	clientRPCErrors     = stats.NewCounters("GrpcClientRPCErrors")
+	clientBytesSent     = stats.NewCounters("GrpcClientBytesSent")
+	clientBytesReceived = stats.NewCounters("GrpcClientBytesReceived")
+)
+
+// statsHandler implements grpc/stats.Handler to update the client stats
+// of the connections to a target.
+type statsHandler struct {
+	target string
+}
+
+// TagRPC is part of the grpc/stats.Handler interface.
+func (h *statsHandler) TagRPC(ctx context.Context, info *grpcstats.RPCTagInfo) context.Context {
+	return ctx
+}
+
+// HandleRPC is part of the grpc/stats.Handler interface.
+func (h *statsHandler) HandleRPC(ctx context.Context, s grpcstats.RPCStats) {
+	switch s := s.(type) {
+	case *grpcstats.Begin:
+		clientRPCsStarted.Add(h.target, 1)
+	case *grpcstats.OutPayload:
+		clientBytesSent.Add(h.target, int64(s.WireLength))
+	case *grpcstats.InPayload:
+		clientBytesReceived.Add(h.target, int64(s.WireLength))
+	case *grpcstats.End:
+		clientRPCsCompleted.Add(h.target, 1)
+		if s.Error != nil {
+			clientRPCErrors.Add(h.target, 1)
+		}
+	}
+}
+
+// TagConn is part of the grpc/stats.Handler interface.
+func (h *statsHandler) TagConn(ctx context.Context, info *grpcstats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+// HandleConn is part of the grpc/stats.Handler interface. It counts the
+// open connections to the target.
+func (h *statsHandler) HandleConn(ctx context.Context, s grpcstats.ConnStats) {
+	switch s.(type) {
+	case *grpcstats.ConnBegin:
+		clientConnections.Add(h.target, 1)
+	case *grpcstats.ConnEnd:
+		clientConnections.Add(h.target, -1)
+	}
+}
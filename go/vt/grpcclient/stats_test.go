@@ -0,0 +1,86 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	grpcstats "google.golang.org/grpc/stats"
+
+	"github.com/youtube/vitess/go/vt/grpccommon"
+)
+
+func TestStatsHandler(t *testing.T) {
+	target := "stats-test:1"
+	h := &statsHandler{target: target}
+
+	ctx := h.TagConn(context.Background(), &grpcstats.ConnTagInfo{})
+	h.HandleConn(ctx, &grpcstats.ConnBegin{Client: true})
+	ctx = h.TagRPC(ctx, &grpcstats.RPCTagInfo{FullMethodName: "/test/Method"})
+	for _, s := range []grpcstats.RPCStats{
+		&grpcstats.Begin{Client: true},
+		&grpcstats.OutPayload{Client: true, WireLength: 10},
+		&grpcstats.InPayload{Client: true, WireLength: 20},
+		&grpcstats.End{Client: true},
+		&grpcstats.Begin{Client: true},
+		&grpcstats.End{Client: true, Error: errors.New("failed")},
+	} {
+		h.HandleRPC(ctx, s)
+	}
+
+	for _, tcase := range []struct {
+		name     string
+		counters map[string]int64
+		want     int64
+	}{
+		{"GrpcClientConnections", clientConnections.Counts(), 1},
+		{"GrpcClientRPCsStarted", clientRPCsStarted.Counts(), 2},
+		{"GrpcClientRPCsCompleted", clientRPCsCompleted.Counts(), 2},
+		{"GrpcClientRPCErrors", clientRPCErrors.Counts(), 1},
+		{"GrpcClientBytesSent", clientBytesSent.Counts(), 10},
+		{"GrpcClientBytesReceived", clientBytesReceived.Counts(), 20},
+	} {
+		if got := tcase.counters[target]; got != tcase.want {
+			t.Errorf("%v[%v]: %v, want %v", tcase.name, target, got, tcase.want)
+		}
+	}
+
+	h.HandleConn(ctx, &grpcstats.ConnEnd{Client: true})
+	if got := clientConnections.Counts()[target]; got != 0 {
+		t.Errorf("GrpcClientConnections[%v] after ConnEnd: %v, want 0", target, got)
+	}
+}
+
+func TestDialClientStats(t *testing.T) {
+	defer func(enabled bool) { *grpccommon.EnableClientStats = enabled }(*grpccommon.EnableClientStats)
+	*grpccommon.EnableClientStats = true
+
+	target, stop := startTestServer(t)
+	defer stop()
+
+	cc, err := Dial(target, grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	defer cc.Close()
+	if got := clientConnections.Counts()[target]; got != 1 {
+		t.Errorf("GrpcClientConnections[%v]: %v, want 1", target, got)
+	}
+}
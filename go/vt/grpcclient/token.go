@@ -0,0 +1,74 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// TokenSource returns the bearer token to send with an RPC.
+type TokenSource func(ctx context.Context) (string, error)
+
+// FileTokenSource returns a TokenSource that reads the token from the
+// given file for every RPC, so that the file can be updated in place.
+func FileTokenSource(path string) TokenSource {
+	return func(ctx context.Context) (string, error) {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read token file: %v", err)
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+}
+
+// BearerTokenDialOption returns the dial option to send the token from
+// source in the authorization metadata of every RPC. Unless
+// allowInsecure is set, grpc refuses to send it over a connection
+// without transport security.
+func BearerTokenDialOption(source TokenSource, allowInsecure bool) grpc.DialOption {
+	return grpc.WithPerRPCCredentials(&bearerToken{
+		source:        source,
+		allowInsecure: allowInsecure,
+	})
+}
+
+// bearerToken implements credentials.PerRPCCredentials.
+type bearerToken struct {
+	source        TokenSource
+	allowInsecure bool
+}
+
+// GetRequestMetadata is part of the credentials.PerRPCCredentials interface.
+func (b *bearerToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	token, err := b.source(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{
+		"authorization": "Bearer " + token,
+	}, nil
+}
+
+// RequireTransportSecurity is part of the credentials.PerRPCCredentials interface.
+func (b *bearerToken) RequireTransportSecurity() bool {
+	return !b.allowInsecure
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/context"
+)
+
+func TestBearerToken(t *testing.T) {
+	f, err := ioutil.TempFile("", "token")
+	if err != nil {
+		t.Fatalf("TempFile failed: %v", err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("secret\n")
+	f.Close()
+
+	b := &bearerToken{source: FileTokenSource(f.Name())}
+	got, err := b.GetRequestMetadata(context.Background())
+	if err != nil {
+		t.Fatalf("GetRequestMetadata failed: %v", err)
+	}
+	want := map[string]string{"authorization": "Bearer secret"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetRequestMetadata: %v, want %v", got, want)
+	}
+	if !b.RequireTransportSecurity() {
+		t.Errorf("RequireTransportSecurity: false, want true")
+	}
+
+	// The file is read again for every RPC.
+	if err := ioutil.WriteFile(f.Name(), []byte("rotated"), 0600); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	got, err = b.GetRequestMetadata(context.Background())
+	if err != nil {
+		t.Fatalf("GetRequestMetadata failed: %v", err)
+	}
+	if want := "Bearer rotated"; got["authorization"] != want {
+		t.Errorf("GetRequestMetadata after rotation: %v, want %v", got["authorization"], want)
+	}
+
+	os.Remove(f.Name())
+	if _, err := b.GetRequestMetadata(context.Background()); err == nil {
+		t.Errorf("GetRequestMetadata with missing file succeeded, want error")
+	}
+
+	b = &bearerToken{
+		source: func(ctx context.Context) (string, error) {
+			return "callback", nil
+		},
+		allowInsecure: true,
+	}
+	got, err = b.GetRequestMetadata(context.Background())
+	if err != nil {
+		t.Fatalf("GetRequestMetadata failed: %v", err)
+	}
+	if want := "Bearer callback"; got["authorization"] != want {
+		t.Errorf("GetRequestMetadata: %v, want %v", got["authorization"], want)
+	}
+	if b.RequireTransportSecurity() {
+		t.Errorf("RequireTransportSecurity with allowInsecure: true, want false")
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"strings"
+
+	log "github.com/golang/glog"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/youtube/vitess/go/trace"
+)
+
+// tracingUnaryInterceptor propagates the span of unary RPCs.
+func tracingUnaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(injectSpan(ctx), method, req, reply, cc, opts...)
+}
+
+// tracingStreamInterceptor propagates the span of streaming RPCs.
+func tracingStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(injectSpan(ctx), desc, cc, method, opts...)
+}
+
+// injectSpan returns a context with the span of ctx added to the
+// outgoing metadata by the installed tracing plugin. It returns ctx as
+// is if there is no span, or if the plugin can't propagate spans.
+func injectSpan(ctx context.Context) context.Context {
+	span, ok := trace.FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	// grpc metadata keys must be lowercase.
+	injected, err := trace.Inject(span, func(key, value string) {
+		key = strings.ToLower(key)
+		md[key] = append(md[key], value)
+	})
+	if err != nil {
+		log.Warningf("Failed to inject span in grpc metadata: %v", err)
+		return ctx
+	}
+	if !injected {
+		return ctx
+	}
+	return metadata.NewOutgoingContext(ctx, md)
+}
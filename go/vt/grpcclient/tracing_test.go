@@ -0,0 +1,104 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"errors"
+	"testing"
+
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/youtube/vitess/go/trace"
+)
+
+// injectingSpan is a trace.Span that injectingSpanFactory propagates.
+type injectingSpan struct {
+	trace.Span
+	id string
+}
+
+type injectingSpanKey struct{}
+
+// injectingSpanFactory is a trace.SpanFactory that implements
+// trace.SpanInjector.
+type injectingSpanFactory struct {
+	err error
+}
+
+func (f injectingSpanFactory) New(parent trace.Span) trace.Span {
+	return injectingSpan{id: "child"}
+}
+
+func (f injectingSpanFactory) FromContext(ctx context.Context) (trace.Span, bool) {
+	span, ok := ctx.Value(injectingSpanKey{}).(trace.Span)
+	return span, ok
+}
+
+func (f injectingSpanFactory) NewContext(parent context.Context, span trace.Span) context.Context {
+	return context.WithValue(parent, injectingSpanKey{}, span)
+}
+
+func (f injectingSpanFactory) Inject(span trace.Span, set func(key, value string)) error {
+	if f.err != nil {
+		return f.err
+	}
+	set("Span-ID", span.(injectingSpan).id)
+	return nil
+}
+
+func TestInjectSpan(t *testing.T) {
+	// With the default plugin, there is no span and nothing is added.
+	ctx := trace.NewContext(context.Background(), trace.NewSpan(nil))
+	if _, ok := metadata.FromOutgoingContext(injectSpan(ctx)); ok {
+		t.Errorf("injectSpan without a tracing plugin added metadata")
+	}
+	// And the tracing interceptors are not installed.
+	if opts := interceptorDialOptions("localhost:1", nil); len(opts) != 0 {
+		t.Errorf("interceptorDialOptions without a tracing plugin: %v, want none", opts)
+	}
+
+	trace.RegisterSpanFactory(injectingSpanFactory{})
+	if opts := interceptorDialOptions("localhost:1", nil); len(opts) != 2 {
+		t.Errorf("interceptorDialOptions with a tracing plugin: %d options, want 2", len(opts))
+	}
+
+	// Without a span, nothing is added either.
+	if _, ok := metadata.FromOutgoingContext(injectSpan(context.Background())); ok {
+		t.Errorf("injectSpan without a span added metadata")
+	}
+
+	// The span is added to the existing metadata, with a lowercase key.
+	ctx = trace.NewContext(context.Background(), injectingSpan{id: "1234"})
+	ctx = metadata.NewOutgoingContext(ctx, metadata.Pairs("key", "value"))
+	md, ok := metadata.FromOutgoingContext(injectSpan(ctx))
+	if !ok {
+		t.Fatalf("injectSpan didn't add metadata")
+	}
+	if got := md["key"]; len(got) != 1 || got[0] != "value" {
+		t.Errorf("injectSpan lost the existing metadata: %v", md)
+	}
+	if got := md["span-id"]; len(got) != 1 || got[0] != "1234" {
+		t.Errorf("injected span-id: %v, want [1234]", got)
+	}
+
+	// On errors, the context is returned as is.
+	trace.RegisterSpanFactory(injectingSpanFactory{err: errors.New("inject failed")})
+	if got := injectSpan(ctx); got != ctx {
+		t.Errorf("injectSpan with an error changed the context")
+	}
+}
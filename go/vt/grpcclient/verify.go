@@ -0,0 +1,121 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	"github.com/youtube/vitess/go/vt/vttls"
+)
+
+// VerifyMode is how SecureDialOptionWithVerify checks the server
+// certificate, on top of the standard chain verification.
+type VerifyMode int
+
+const (
+	// VerifyHostname only verifies the chain and the server name.
+	VerifyHostname VerifyMode = iota
+
+	// VerifyPinnedSPKI also requires the public key of the server
+	// certificate to be one of VerifyOptions.PinnedSPKI.
+	VerifyPinnedSPKI
+
+	// VerifyCustom also calls VerifyOptions.VerifyPeerCertificate.
+	VerifyCustom
+)
+
+// VerifyOptions controls how the server certificate is verified.
+type VerifyOptions struct {
+	Mode VerifyMode
+
+	// ServerName is the name the server certificate must be valid
+	// for. It allows dialing by IP while verifying a specific SAN.
+	ServerName string
+
+	// PinnedSPKI is the list of accepted SHA-256 hashes of the DER
+	// encoded SubjectPublicKeyInfo of the server certificate.
+	PinnedSPKI [][]byte
+
+	// VerifyPeerCertificate is called with the verified chains, see
+	// tls.Config.VerifyPeerCertificate.
+	VerifyPeerCertificate func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error
+}
+
+// SecureDialOptionWithVerify is like SecureDialOption, but with more
+// control over the verification of the server certificate. The chain is
+// always verified, against ca or the system roots if ca is not set, so
+// there is no way to skip the verification.
+func SecureDialOptionWithVerify(cert, key, ca string, verify VerifyOptions) (grpc.DialOption, error) {
+	config, err := verifyConfig(cert, key, ca, verify)
+	if err != nil {
+		return nil, err
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(config)), nil
+}
+
+// verifyConfig returns the client TLS config for the given options.
+func verifyConfig(cert, key, ca string, verify VerifyOptions) (*tls.Config, error) {
+	config, err := vttls.ClientConfig(cert, key, ca, verify.ServerName)
+	if err != nil {
+		return nil, err
+	}
+	if err := setTLSMinVersion(config); err != nil {
+		return nil, err
+	}
+
+	switch verify.Mode {
+	case VerifyHostname:
+	case VerifyPinnedSPKI:
+		if len(verify.PinnedSPKI) == 0 {
+			return nil, fmt.Errorf("no pinned SPKI hashes for VerifyPinnedSPKI")
+		}
+		pins := verify.PinnedSPKI
+		config.VerifyPeerCertificate = func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+			return verifyPinnedSPKI(pins, verifiedChains)
+		}
+	case VerifyCustom:
+		if verify.VerifyPeerCertificate == nil {
+			return nil, fmt.Errorf("no VerifyPeerCertificate callback for VerifyCustom")
+		}
+		config.VerifyPeerCertificate = verify.VerifyPeerCertificate
+	default:
+		return nil, fmt.Errorf("unknown verify mode %v", verify.Mode)
+	}
+	return config, nil
+}
+
+// verifyPinnedSPKI checks the public key of the leaf certificate against
+// the pinned hashes.
+func verifyPinnedSPKI(pins [][]byte, verifiedChains [][]*x509.Certificate) error {
+	if len(verifiedChains) == 0 || len(verifiedChains[0]) == 0 {
+		return fmt.Errorf("no verified server certificate")
+	}
+	hash := sha256.Sum256(verifiedChains[0][0].RawSubjectPublicKeyInfo)
+	for _, pin := range pins {
+		if bytes.Equal(pin, hash[:]) {
+			return nil
+		}
+	}
+	return fmt.Errorf("server certificate public key is not pinned")
+}
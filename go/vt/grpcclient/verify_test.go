@@ -0,0 +1,134 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcclient
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/youtube/vitess/go/vt/tlstest"
+	"github.com/youtube/vitess/go/vt/vttls"
+)
+
+func TestVerifyConfig(t *testing.T) {
+	root, err := ioutil.TempDir("", "grpcclient")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	tlstest.CreateCA(root)
+	tlstest.CreateSignedCert(root, tlstest.CA, "01", "servers", "Servers CA")
+	tlstest.CreateSignedCert(root, "servers", "01", "server-instance", "Server Instance")
+
+	serverCert := path.Join(root, "server-instance-cert.pem")
+	serverConfig, err := vttls.ServerConfig(serverCert, path.Join(root, "server-instance-key.pem"), "")
+	if err != nil {
+		t.Fatalf("ServerConfig failed: %v", err)
+	}
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", serverConfig)
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			conn.(*tls.Conn).Handshake()
+			conn.Close()
+		}
+	}()
+
+	crt, err := tls.LoadX509KeyPair(serverCert, path.Join(root, "server-instance-key.pem"))
+	if err != nil {
+		t.Fatalf("LoadX509KeyPair failed: %v", err)
+	}
+	leaf, err := x509.ParseCertificate(crt.Certificate[0])
+	if err != nil {
+		t.Fatalf("ParseCertificate failed: %v", err)
+	}
+	pin := sha256.Sum256(leaf.RawSubjectPublicKeyInfo)
+
+	customErr := errors.New("rejected by callback")
+	testcases := []struct {
+		desc   string
+		verify VerifyOptions
+		ok     bool
+	}{{
+		desc:   "hostname",
+		verify: VerifyOptions{ServerName: "Server Instance"},
+		ok:     true,
+	}, {
+		desc:   "wrong hostname",
+		verify: VerifyOptions{ServerName: "Other Instance"},
+	}, {
+		desc: "pinned",
+		verify: VerifyOptions{
+			Mode:       VerifyPinnedSPKI,
+			ServerName: "Server Instance",
+			PinnedSPKI: [][]byte{[]byte("other"), pin[:]},
+		},
+		ok: true,
+	}, {
+		desc: "not pinned",
+		verify: VerifyOptions{
+			Mode:       VerifyPinnedSPKI,
+			ServerName: "Server Instance",
+			PinnedSPKI: [][]byte{[]byte("other")},
+		},
+	}, {
+		desc: "custom",
+		verify: VerifyOptions{
+			Mode:       VerifyCustom,
+			ServerName: "Server Instance",
+			VerifyPeerCertificate: func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+				return customErr
+			},
+		},
+	}}
+	for _, tcase := range testcases {
+		config, err := verifyConfig("", "", path.Join(root, "servers-cert.pem"), tcase.verify)
+		if err != nil {
+			t.Errorf("%s: verifyConfig failed: %v", tcase.desc, err)
+			continue
+		}
+		conn, err := tls.Dial("tcp", listener.Addr().String(), config)
+		if err == nil {
+			conn.Close()
+		}
+		if got := err == nil; got != tcase.ok {
+			t.Errorf("%s: handshake error: %v, want success: %v", tcase.desc, err, tcase.ok)
+		}
+	}
+
+	// Modes without what they need to verify are rejected.
+	if _, err := verifyConfig("", "", "", VerifyOptions{Mode: VerifyPinnedSPKI}); err == nil {
+		t.Errorf("verifyConfig without pins succeeded, want error")
+	}
+	if _, err := verifyConfig("", "", "", VerifyOptions{Mode: VerifyCustom}); err == nil {
+		t.Errorf("verifyConfig without callback succeeded, want error")
+	}
+}
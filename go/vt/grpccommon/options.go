@@ -18,6 +18,7 @@ package grpccommon
 
 import (
 	"flag"
+	"time"
 )
 
 var (
@@ -27,4 +28,53 @@ var (
 	// Note: We're using 4 MiB as default value because that's the default in the
 	// gRPC 1.0.0 Go server.
 	MaxMessageSize = flag.Int("grpc_max_message_size", defaultMaxMessageSize, "Maximum allowed RPC message size. Larger messages will be rejected by gRPC with the error 'exceeding the max size'.")
+
+	// KeepaliveTime is the interval of inactivity after which a client
+	// pings the server to check that the connection is still alive. Zero
+	// disables keepalive pings.
+	// Note: gRPC servers reject pings that are sent more often than every
+	// 5 minutes by default.
+	KeepaliveTime = flag.Duration("grpc_keepalive_time", 0, "After this duration of inactivity the client pings the server to check that the connection is still alive. Zero disables keepalive pings.")
+
+	// KeepaliveTimeout is how long a client waits for the reply to a
+	// keepalive ping before closing the connection.
+	KeepaliveTimeout = flag.Duration("grpc_keepalive_timeout", 20*time.Second, "After pinging the server for keepalive, the client waits this long for a reply before closing the connection.")
+
+	// KeepalivePermitWithoutStream allows keepalive pings on connections
+	// without any active RPC.
+	KeepalivePermitWithoutStream = flag.Bool("grpc_keepalive_permit_without_stream", false, "Whether the client sends keepalive pings even when there are no active RPCs.")
+
+	// ClientCompression is the compression that clients use for the
+	// messages they send. Only gzip is supported, and compression is off
+	// if empty.
+	ClientCompression = flag.String("grpc_client_compression", "", "Compression to use for the messages sent by gRPC clients, either gzip or empty for none.")
+
+	// ConnectTimeout bounds how long a blocking dial waits for the
+	// connection to be established, on top of any deadline of the caller.
+	// Zero means no timeout.
+	ConnectTimeout = flag.Duration("grpc_connect_timeout", 0, "Maximum time to wait for a gRPC client connection to be established. Zero means no timeout.")
+
+	// MaxBackoff is the maximum delay between attempts to reconnect a
+	// client connection. The default is the one of gRPC. The initial
+	// delay is not configurable: the vendored gRPC doesn't expose it,
+	// and it stays at 1 second.
+	MaxBackoff = flag.Duration("grpc_max_backoff", 120*time.Second, "Maximum delay between attempts to reconnect a gRPC client connection. The delay starts at 1s, which is not configurable.")
+
+	// ClientLoadBalancing is how clients pick the address to use when
+	// their target resolves to several addresses, either pick_first
+	// (the default) or round_robin.
+	ClientLoadBalancing = flag.String("grpc_client_load_balancing", "pick_first", "How gRPC clients spread RPCs across the addresses of their target, either pick_first or round_robin. round_robin resolves the target with DNS.")
+
+	// TLSMinVersion is the minimum TLS version that clients accept from
+	// servers, to reject downgrades to older versions.
+	TLSMinVersion = flag.String("grpc_tls_min_version", "1.2", "Minimum TLS version accepted by gRPC clients that use TLS: 1.0, 1.1 or 1.2.")
+
+	// EnableClientStats makes clients export the number of their open
+	// connections, RPCs, errors and bytes, by target.
+	EnableClientStats = flag.Bool("grpc_client_stats", false, "Whether gRPC clients export stats about their connections and RPCs for each target.")
+
+	// PoolDrainTimeout is how long the connection pool of the clients
+	// waits for the in-flight RPCs of a connection it closes, before
+	// closing it anyway. Zero closes connections right away.
+	PoolDrainTimeout = flag.Duration("grpc_pool_drain_timeout", 5*time.Second, "Maximum time to wait for the in-flight RPCs of a pooled gRPC client connection to finish when it is closed, before canceling them.")
 )
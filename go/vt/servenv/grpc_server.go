@@ -115,6 +115,10 @@ func createGRPCServer() {
 	opts = append(opts, grpc.MaxRecvMsgSize(*grpccommon.MaxMessageSize))
 	opts = append(opts, grpc.MaxSendMsgSize(*grpccommon.MaxMessageSize))
 
+	// Accept gzip compressed messages from clients that use
+	// -grpc_client_compression.
+	opts = append(opts, grpc.RPCDecompressor(grpc.NewGZIPDecompressor()))
+
 	if GRPCMaxConnectionAge != nil {
 		ka := keepalive.ServerParameters{
 			MaxConnectionAge: *GRPCMaxConnectionAge,
@@ -689,9 +689,10 @@ func (node *PartitionDefinition) WalkSubtree(visit Visit) error {
 
 // TableSpec describes the structure of a table from a CREATE TABLE statement
 type TableSpec struct {
-	Columns []*ColumnDefinition
-	Indexes []*IndexDefinition
-	Options string
+	Columns     []*ColumnDefinition
+	Indexes     []*IndexDefinition
+	ForeignKeys []*ForeignKeyDefinition
+	Options     string
 }
 
 // Format formats the node.
@@ -707,6 +708,9 @@ func (ts *TableSpec) Format(buf *TrackedBuffer) {
 	for _, idx := range ts.Indexes {
 		buf.Myprintf(",\n\t%v", idx)
 	}
+	for _, fk := range ts.ForeignKeys {
+		buf.Myprintf(",\n\t%v", fk)
+	}
 
 	buf.Myprintf("\n)%s", strings.Replace(ts.Options, ", ", ",\n  ", -1))
 }
@@ -721,6 +725,11 @@ func (ts *TableSpec) AddIndex(id *IndexDefinition) {
 	ts.Indexes = append(ts.Indexes, id)
 }
 
+// AddForeignKey appends the given foreign key to the list in the spec
+func (ts *TableSpec) AddForeignKey(fk *ForeignKeyDefinition) {
+	ts.ForeignKeys = append(ts.ForeignKeys, fk)
+}
+
 // WalkSubtree walks the nodes of the subtree.
 func (ts *TableSpec) WalkSubtree(visit Visit) error {
 	if ts == nil {
@@ -739,6 +748,12 @@ func (ts *TableSpec) WalkSubtree(visit Visit) error {
 		}
 	}
 
+	for _, n := range ts.ForeignKeys {
+		if err := Walk(visit, n); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -791,6 +806,12 @@ type ColumnType struct {
 	// Enum values
 	EnumValues []string
 
+	// Generated column options. GeneratedExpr is set for columns
+	// whose values are computed from the given expression, and Stored
+	// is set if the value is persisted rather than virtual.
+	GeneratedExpr Expr
+	Stored        BoolVal
+
 	// Key specification
 	KeyOpt ColumnKeyOption
 }
@@ -823,6 +844,9 @@ func (ct *ColumnType) Format(buf *TrackedBuffer) {
 	if ct.Collate != "" {
 		opts = append(opts, keywordStrings[COLLATE], ct.Collate)
 	}
+	if ct.GeneratedExpr != nil {
+		opts = append(opts, keywordStrings[AS], "("+String(ct.GeneratedExpr)+")", ct.GeneratedOption())
+	}
 	if ct.NotNull {
 		opts = append(opts, keywordStrings[NOT], keywordStrings[NULL])
 	}
@@ -866,6 +890,9 @@ func (ct *ColumnType) DescribeType() string {
 	} else if ct.Length != nil {
 		buf.Myprintf("(%v)", ct.Length)
 	}
+	if ct.EnumValues != nil {
+		buf.Myprintf("(%s)", strings.Join(ct.EnumValues, ","))
+	}
 
 	opts := make([]string, 0, 16)
 	if ct.Unsigned {
@@ -880,6 +907,19 @@ func (ct *ColumnType) DescribeType() string {
 	return buf.String()
 }
 
+// GeneratedOption returns how the value of a generated column is kept,
+// i.e. "virtual" or "stored", or an empty string if the column is not
+// generated.
+func (ct *ColumnType) GeneratedOption() string {
+	if ct.GeneratedExpr == nil {
+		return ""
+	}
+	if ct.Stored {
+		return "stored"
+	}
+	return "virtual"
+}
+
 // SQLType returns the sqltypes type code for the given column
 func (ct *ColumnType) SQLType() querypb.Type {
 	switch ct.Type {
@@ -954,6 +994,8 @@ func (ct *ColumnType) SQLType() querypb.Type {
 		return sqltypes.Bit
 	case keywordStrings[ENUM]:
 		return sqltypes.Enum
+	case keywordStrings[SET]:
+		return sqltypes.Set
 	case keywordStrings[JSON]:
 		return sqltypes.TypeJSON
 	}
@@ -1002,6 +1044,37 @@ func (idx *IndexDefinition) WalkSubtree(visit Visit) error {
 	return nil
 }
 
+// ForeignKeyDefinition describes a foreign key constraint in a CREATE TABLE
+// statement. Name is empty if the constraint is not named.
+type ForeignKeyDefinition struct {
+	Name              ColIdent
+	Source            Columns
+	ReferencedTable   TableName
+	ReferencedColumns Columns
+}
+
+// Format formats the node.
+func (fk *ForeignKeyDefinition) Format(buf *TrackedBuffer) {
+	if !fk.Name.IsEmpty() {
+		buf.Myprintf("constraint %v ", fk.Name)
+	}
+	buf.Myprintf("foreign key %v references %v %v", fk.Source, fk.ReferencedTable, fk.ReferencedColumns)
+}
+
+// WalkSubtree walks the nodes of the subtree.
+func (fk *ForeignKeyDefinition) WalkSubtree(visit Visit) error {
+	if fk == nil {
+		return nil
+	}
+	return Walk(
+		visit,
+		fk.Name,
+		fk.Source,
+		fk.ReferencedTable,
+		fk.ReferencedColumns,
+	)
+}
+
 // IndexInfo describes the name and type of an index in a CREATE TABLE statement
 type IndexInfo struct {
 	Type    string
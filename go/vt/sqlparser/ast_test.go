@@ -262,6 +262,47 @@ func TestColNameEqual(t *testing.T) {
 	}
 }
 
+func TestColumnTypeGenerated(t *testing.T) {
+	expr := &BinaryExpr{
+		Operator: PlusStr,
+		Left:     &ColName{Name: NewColIdent("a")},
+		Right:    &ColName{Name: NewColIdent("b")},
+	}
+	ct := &ColumnType{Type: "int", NotNull: true}
+	if got := ct.GeneratedOption(); got != "" {
+		t.Errorf("GeneratedOption: %q, want empty", got)
+	}
+
+	ct.GeneratedExpr = expr
+	if got, want := String(ct), "int as (a + b) virtual not null"; got != want {
+		t.Errorf("String: %s, want %s", got, want)
+	}
+
+	ct.Stored = true
+	if got, want := String(ct), "int as (a + b) stored not null"; got != want {
+		t.Errorf("String: %s, want %s", got, want)
+	}
+	if got, want := ct.GeneratedOption(), "stored"; got != want {
+		t.Errorf("GeneratedOption: %s, want %s", got, want)
+	}
+}
+
+func TestForeignKeyDefinition(t *testing.T) {
+	fk := &ForeignKeyDefinition{
+		Source:            Columns{NewColIdent("a"), NewColIdent("b")},
+		ReferencedTable:   TableName{Name: NewTableIdent("t2")},
+		ReferencedColumns: Columns{NewColIdent("c"), NewColIdent("d")},
+	}
+	if got, want := String(fk), "foreign key (a, b) references t2 (c, d)"; got != want {
+		t.Errorf("String: %s, want %s", got, want)
+	}
+
+	fk.Name = NewColIdent("fk_t2")
+	if got, want := String(fk), "constraint fk_t2 foreign key (a, b) references t2 (c, d)"; got != want {
+		t.Errorf("String: %s, want %s", got, want)
+	}
+}
+
 func TestColIdent(t *testing.T) {
 	str := NewColIdent("Ab")
 	if str.String() != "Ab" {
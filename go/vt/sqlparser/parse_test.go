@@ -1268,7 +1268,15 @@ func TestCreateTable(t *testing.T) {
 			"	col_longtext longtext,\n" +
 			"	col_text text character set ascii collate ascii_bin,\n" +
 			"	col_json json,\n" +
-			"	col_enum enum('a', 'b', 'c', 'd')\n" +
+			"	col_enum enum('a', 'b', 'c', 'd'),\n" +
+			"	col_set set('a', 'b', 'c', 'd')\n" +
+			")",
+
+		// test generated columns
+		"create table t (\n" +
+			"	a int,\n" +
+			"	b int as (a + 1) virtual,\n" +
+			"	c varchar(10) as (concat(a, 'x')) stored not null comment 'derived'\n" +
 			")",
 
 		// test defaults
@@ -1321,6 +1329,17 @@ func TestCreateTable(t *testing.T) {
 			"	key by_email (email(10), username)\n" +
 			")",
 
+		// foreign keys
+		"create table t (\n" +
+			"	id int,\n" +
+			"	a_id int,\n" +
+			"	b_id1 int,\n" +
+			"	b_id2 int,\n" +
+			"	primary key (id),\n" +
+			"	foreign key (a_id) references a (id),\n" +
+			"	constraint t_b foreign key (b_id1, b_id2) references ks.b (id1, id2)\n" +
+			")",
+
 		// table options
 		"create table t (\n" +
 			"	id int auto_increment\n" +
@@ -1407,6 +1426,40 @@ func TestCreateTableEscaped(t *testing.T) {
 	}
 }
 
+func TestCreateTableGenerated(t *testing.T) {
+	testCases := []struct {
+		input  string
+		output string
+	}{{
+		input: "create table t(a int, b int generated always as (a+1))",
+		output: "create table t (\n" +
+			"\ta int,\n" +
+			"\tb int as (a + 1) virtual\n" +
+			")",
+	}, {
+		input: "create table t(a int, b int as (a+1) STORED, `stored` int)",
+		output: "create table t (\n" +
+			"\ta int,\n" +
+			"\tb int as (a + 1) stored,\n" +
+			"\t`stored` int\n" +
+			")",
+	}}
+	for _, tcase := range testCases {
+		tree, err := ParseStrictDDL(tcase.input)
+		if err != nil {
+			t.Errorf("input: %s, err: %v", tcase.input, err)
+			continue
+		}
+		if got, want := String(tree.(*DDL)), tcase.output; got != want {
+			t.Errorf("Parse(%s):\n%s, want\n%s", tcase.input, got, want)
+		}
+		col := tree.(*DDL).TableSpec.Columns[1]
+		if col.Type.GeneratedExpr == nil {
+			t.Errorf("Parse(%s): column %v is not generated", tcase.input, col.Name)
+		}
+	}
+}
+
 var (
 	invalidSQL = []struct {
 		input        string
@@ -37,58 +37,59 @@ func forceEOF(yylex interface{}) {
 
 //line sql.y:53
 type yySymType struct {
-	yys               int
-	empty             struct{}
-	statement         Statement
-	selStmt           SelectStatement
-	ddl               *DDL
-	ins               *Insert
-	byt               byte
-	bytes             []byte
-	bytes2            [][]byte
-	str               string
-	strs              []string
-	selectExprs       SelectExprs
-	selectExpr        SelectExpr
-	columns           Columns
-	colName           *ColName
-	tableExprs        TableExprs
-	tableExpr         TableExpr
-	tableName         TableName
-	tableNames        TableNames
-	indexHints        *IndexHints
-	expr              Expr
-	exprs             Exprs
-	boolVal           BoolVal
-	colTuple          ColTuple
-	values            Values
-	valTuple          ValTuple
-	subquery          *Subquery
-	whens             []*When
-	when              *When
-	orderBy           OrderBy
-	order             *Order
-	limit             *Limit
-	updateExprs       UpdateExprs
-	updateExpr        *UpdateExpr
-	colIdent          ColIdent
-	colIdents         []ColIdent
-	tableIdent        TableIdent
-	convertType       *ConvertType
-	aliasedTableName  *AliasedTableExpr
-	TableSpec         *TableSpec
-	columnType        ColumnType
-	colKeyOpt         ColumnKeyOption
-	optVal            *SQLVal
-	LengthScaleOption LengthScaleOption
-	columnDefinition  *ColumnDefinition
-	indexDefinition   *IndexDefinition
-	indexInfo         *IndexInfo
-	indexColumn       *IndexColumn
-	indexColumns      []*IndexColumn
-	partDefs          []*PartitionDefinition
-	partDef           *PartitionDefinition
-	partSpec          *PartitionSpec
+	yys                  int
+	empty                struct{}
+	statement            Statement
+	selStmt              SelectStatement
+	ddl                  *DDL
+	ins                  *Insert
+	byt                  byte
+	bytes                []byte
+	bytes2               [][]byte
+	str                  string
+	strs                 []string
+	selectExprs          SelectExprs
+	selectExpr           SelectExpr
+	columns              Columns
+	colName              *ColName
+	tableExprs           TableExprs
+	tableExpr            TableExpr
+	tableName            TableName
+	tableNames           TableNames
+	indexHints           *IndexHints
+	expr                 Expr
+	exprs                Exprs
+	boolVal              BoolVal
+	colTuple             ColTuple
+	values               Values
+	valTuple             ValTuple
+	subquery             *Subquery
+	whens                []*When
+	when                 *When
+	orderBy              OrderBy
+	order                *Order
+	limit                *Limit
+	updateExprs          UpdateExprs
+	updateExpr           *UpdateExpr
+	colIdent             ColIdent
+	colIdents            []ColIdent
+	tableIdent           TableIdent
+	convertType          *ConvertType
+	aliasedTableName     *AliasedTableExpr
+	TableSpec            *TableSpec
+	columnType           ColumnType
+	colKeyOpt            ColumnKeyOption
+	optVal               *SQLVal
+	LengthScaleOption    LengthScaleOption
+	columnDefinition     *ColumnDefinition
+	indexDefinition      *IndexDefinition
+	foreignKeyDefinition *ForeignKeyDefinition
+	indexInfo            *IndexInfo
+	indexColumn          *IndexColumn
+	indexColumns         []*IndexColumn
+	partDefs             []*PartitionDefinition
+	partDef              *PartitionDefinition
+	partSpec             *PartitionSpec
 }
 
 const LEX_ERROR = 57346
@@ -206,74 +207,81 @@ const PARTITION = 57457
 const REORGANIZE = 57458
 const LESS = 57459
 const THAN = 57460
-const BIT = 57461
-const TINYINT = 57462
-const SMALLINT = 57463
-const MEDIUMINT = 57464
-const INT = 57465
-const INTEGER = 57466
-const BIGINT = 57467
-const INTNUM = 57468
-const REAL = 57469
-const DOUBLE = 57470
-const FLOAT_TYPE = 57471
-const DECIMAL = 57472
-const NUMERIC = 57473
-const TIME = 57474
-const TIMESTAMP = 57475
-const DATETIME = 57476
-const YEAR = 57477
-const CHAR = 57478
-const VARCHAR = 57479
-const BOOL = 57480
-const CHARACTER = 57481
-const VARBINARY = 57482
-const NCHAR = 57483
-const TEXT = 57484
-const TINYTEXT = 57485
-const MEDIUMTEXT = 57486
-const LONGTEXT = 57487
-const BLOB = 57488
-const TINYBLOB = 57489
-const MEDIUMBLOB = 57490
-const LONGBLOB = 57491
-const JSON = 57492
-const ENUM = 57493
-const NULLX = 57494
-const AUTO_INCREMENT = 57495
-const APPROXNUM = 57496
-const SIGNED = 57497
-const UNSIGNED = 57498
-const ZEROFILL = 57499
-const DATABASES = 57500
-const TABLES = 57501
-const VITESS_KEYSPACES = 57502
-const VITESS_SHARDS = 57503
-const VSCHEMA_TABLES = 57504
-const NAMES = 57505
-const CHARSET = 57506
-const CURRENT_TIMESTAMP = 57507
-const DATABASE = 57508
-const CURRENT_DATE = 57509
-const CURRENT_TIME = 57510
-const LOCALTIME = 57511
-const LOCALTIMESTAMP = 57512
-const UTC_DATE = 57513
-const UTC_TIME = 57514
-const UTC_TIMESTAMP = 57515
-const REPLACE = 57516
-const CONVERT = 57517
-const CAST = 57518
-const GROUP_CONCAT = 57519
-const SEPARATOR = 57520
-const MATCH = 57521
-const AGAINST = 57522
-const BOOLEAN = 57523
-const LANGUAGE = 57524
-const WITH = 57525
-const QUERY = 57526
-const EXPANSION = 57527
-const UNUSED = 57528
+const GENERATED = 57461
+const ALWAYS = 57462
+const STORED = 57463
+const VIRTUAL = 57464
+const CONSTRAINT = 57465
+const FOREIGN = 57466
+const REFERENCES = 57467
+const BIT = 57468
+const TINYINT = 57469
+const SMALLINT = 57470
+const MEDIUMINT = 57471
+const INT = 57472
+const INTEGER = 57473
+const BIGINT = 57474
+const INTNUM = 57475
+const REAL = 57476
+const DOUBLE = 57477
+const FLOAT_TYPE = 57478
+const DECIMAL = 57479
+const NUMERIC = 57480
+const TIME = 57481
+const TIMESTAMP = 57482
+const DATETIME = 57483
+const YEAR = 57484
+const CHAR = 57485
+const VARCHAR = 57486
+const BOOL = 57487
+const CHARACTER = 57488
+const VARBINARY = 57489
+const NCHAR = 57490
+const TEXT = 57491
+const TINYTEXT = 57492
+const MEDIUMTEXT = 57493
+const LONGTEXT = 57494
+const BLOB = 57495
+const TINYBLOB = 57496
+const MEDIUMBLOB = 57497
+const LONGBLOB = 57498
+const JSON = 57499
+const ENUM = 57500
+const NULLX = 57501
+const AUTO_INCREMENT = 57502
+const APPROXNUM = 57503
+const SIGNED = 57504
+const UNSIGNED = 57505
+const ZEROFILL = 57506
+const DATABASES = 57507
+const TABLES = 57508
+const VITESS_KEYSPACES = 57509
+const VITESS_SHARDS = 57510
+const VSCHEMA_TABLES = 57511
+const NAMES = 57512
+const CHARSET = 57513
+const CURRENT_TIMESTAMP = 57514
+const DATABASE = 57515
+const CURRENT_DATE = 57516
+const CURRENT_TIME = 57517
+const LOCALTIME = 57518
+const LOCALTIMESTAMP = 57519
+const UTC_DATE = 57520
+const UTC_TIME = 57521
+const UTC_TIMESTAMP = 57522
+const REPLACE = 57523
+const CONVERT = 57524
+const CAST = 57525
+const GROUP_CONCAT = 57526
+const SEPARATOR = 57527
+const MATCH = 57528
+const AGAINST = 57529
+const BOOLEAN = 57530
+const LANGUAGE = 57531
+const WITH = 57532
+const QUERY = 57533
+const EXPANSION = 57534
+const UNUSED = 57535
 
 var yyToknames = [...]string{
 	"$end",
@@ -411,6 +419,13 @@ var yyToknames = [...]string{
 	"REORGANIZE",
 	"LESS",
 	"THAN",
+	"GENERATED",
+	"ALWAYS",
+	"STORED",
+	"VIRTUAL",
+	"CONSTRAINT",
+	"FOREIGN",
+	"REFERENCES",
 	"BIT",
 	"TINYINT",
 	"SMALLINT",
@@ -495,824 +510,851 @@ var yyExca = [...]int{
 	-1, 3,
 	5, 22,
 	-2, 4,
-	-1, 264,
-	77, 559,
-	106, 559,
+	-1, 271,
+	77, 574,
+	106, 574,
 	-2, 39,
-	-1, 266,
-	77, 581,
-	106, 581,
+	-1, 273,
+	77, 599,
+	106, 599,
 	-2, 41,
-	-1, 271,
-	106, 460,
-	-2, 456,
-	-1, 272,
-	106, 461,
-	-2, 457,
-	-1, 548,
+	-1, 278,
+	106, 474,
+	-2, 470,
+	-1, 279,
+	106, 475,
+	-2, 471,
+	-1, 411,
+	21, 107,
+	-2, 105,
+	-1, 556,
 	5, 22,
-	-2, 407,
-	-1, 582,
-	106, 463,
-	-2, 459,
-	-1, 735,
+	-2, 421,
+	-1, 590,
+	106, 477,
+	-2, 473,
+	-1, 747,
 	5, 23,
-	-2, 284,
-	-1, 827,
+	-2, 298,
+	-1, 844,
 	5, 23,
-	-2, 408,
-	-1, 900,
+	-2, 422,
+	-1, 916,
 	5, 22,
-	-2, 410,
-	-1, 967,
+	-2, 424,
+	-1, 990,
 	5, 23,
-	-2, 411,
+	-2, 425,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 7594
+const yyLast = 7832
 
 var yyAct = [...]int{
 
-	332, 38, 975, 507, 842, 305, 300, 263, 723, 444,
-	608, 724, 877, 380, 381, 3, 272, 442, 621, 573,
-	585, 905, 680, 397, 331, 602, 690, 789, 797, 687,
-	704, 294, 581, 720, 237, 231, 584, 594, 657, 38,
-	354, 759, 63, 303, 360, 267, 135, 242, 141, 135,
-	274, 44, 257, 236, 384, 140, 617, 369, 246, 268,
-	43, 253, 1003, 48, 994, 251, 292, 1000, 135, 135,
-	232, 233, 234, 235, 135, 252, 989, 998, 993, 988,
-	890, 637, 936, 278, 50, 51, 52, 53, 953, 755,
-	601, 913, 959, 609, 931, 635, 978, 473, 472, 482,
-	483, 475, 476, 477, 478, 479, 480, 481, 474, 929,
-	997, 484, 847, 848, 849, 995, 976, 779, 284, 806,
-	641, 850, 951, 596, 391, 625, 776, 569, 571, 634,
-	307, 285, 778, 280, 126, 461, 460, 878, 125, 445,
-	126, 450, 135, 738, 135, 128, 129, 130, 135, 275,
-	596, 737, 462, 736, 135, 276, 281, 137, 127, 519,
-	880, 496, 497, 856, 943, 390, 921, 830, 801, 742,
-	689, 506, 401, 760, 291, 289, 749, 631, 636, 629,
-	484, 882, 256, 886, 459, 881, 329, 879, 474, 807,
-	462, 484, 884, 461, 460, 609, 400, 892, 570, 639,
-	642, 883, 464, 534, 535, 460, 885, 887, 705, 979,
-	462, 595, 61, 857, 447, 952, 593, 950, 592, 808,
-	777, 462, 775, 633, 477, 478, 479, 480, 481, 474,
-	664, 987, 484, 753, 971, 392, 463, 632, 595, 851,
-	269, 41, 362, 38, 662, 663, 661, 461, 460, 287,
-	917, 660, 461, 460, 461, 460, 916, 357, 382, 638,
-	681, 894, 682, 598, 462, 461, 460, 135, 599, 462,
-	640, 462, 394, 768, 135, 135, 135, 767, 443, 63,
-	855, 756, 462, 443, 705, 982, 813, 962, 650, 652,
-	653, 915, 356, 651, 63, 766, 135, 293, 135, 63,
-	449, 135, 493, 495, 135, 844, 135, 358, 782, 783,
-	784, 984, 293, 457, 750, 498, 499, 500, 501, 502,
-	503, 504, 455, 945, 293, 910, 909, 795, 293, 955,
-	505, 862, 861, 509, 510, 511, 512, 513, 514, 515,
-	683, 518, 520, 520, 520, 520, 520, 520, 520, 520,
-	528, 529, 530, 531, 482, 483, 475, 476, 477, 478,
-	479, 480, 481, 474, 549, 441, 484, 286, 257, 257,
-	257, 257, 268, 275, 551, 954, 63, 852, 548, 859,
-	858, 135, 574, 382, 135, 135, 135, 135, 256, 695,
-	257, 536, 829, 293, 45, 135, 398, 567, 19, 135,
-	695, 293, 135, 575, 268, 822, 135, 135, 367, 293,
-	574, 580, 586, 403, 402, 825, 19, 538, 721, 63,
-	398, 546, 367, 547, 537, 582, 610, 611, 612, 577,
-	553, 795, 555, 494, 366, 563, 604, 605, 606, 607,
-	572, 899, 41, 552, 578, 554, 795, 565, 566, 396,
-	398, 614, 615, 616, 589, 443, 367, 367, 860, 623,
-	41, 124, 135, 795, 396, 19, 743, 135, 532, 396,
-	135, 63, 645, 656, 243, 41, 665, 666, 667, 668,
-	669, 670, 671, 672, 673, 674, 675, 676, 677, 678,
-	679, 603, 658, 622, 619, 620, 38, 746, 256, 256,
-	256, 256, 521, 522, 523, 524, 525, 526, 527, 41,
-	509, 618, 250, 256, 613, 55, 694, 544, 41, 846,
-	256, 63, 769, 731, 567, 371, 374, 375, 376, 372,
-	582, 373, 377, 560, 721, 63, 453, 17, 561, 558,
-	684, 685, 734, 733, 559, 557, 540, 556, 726, 996,
-	38, 992, 268, 269, 722, 562, 781, 375, 376, 709,
-	702, 247, 248, 692, 727, 646, 63, 991, 713, 718,
-	717, 730, 725, 712, 696, 761, 475, 476, 477, 478,
-	479, 480, 481, 474, 241, 269, 484, 707, 396, 396,
-	361, 399, 295, 752, 586, 741, 973, 823, 659, 972,
-	897, 63, 747, 359, 296, 744, 919, 627, 320, 319,
-	322, 323, 324, 325, 452, 757, 758, 321, 326, 379,
-	244, 245, 361, 238, 716, 739, 965, 443, 239, 45,
-	964, 748, 715, 939, 735, 574, 940, 914, 47, 458,
-	49, 396, 63, 63, 771, 443, 389, 42, 1, 630,
-	974, 841, 762, 763, 764, 591, 583, 273, 54, 590,
-	765, 63, 780, 949, 772, 912, 597, 754, 600, 786,
-	787, 788, 845, 371, 374, 375, 376, 372, 970, 373,
-	377, 697, 698, 732, 751, 701, 658, 406, 407, 405,
-	409, 686, 408, 396, 404, 802, 138, 378, 383, 708,
-	785, 710, 711, 393, 796, 706, 624, 56, 774, 63,
-	773, 628, 279, 492, 719, 714, 262, 728, 473, 472,
-	482, 483, 475, 476, 477, 478, 479, 480, 481, 474,
-	533, 135, 484, 269, 812, 353, 729, 834, 835, 836,
-	963, 938, 811, 516, 703, 306, 649, 318, 315, 317,
-	792, 316, 838, 586, 793, 586, 824, 790, 539, 63,
-	63, 545, 63, 63, 804, 805, 840, 466, 809, 304,
-	298, 396, 568, 815, 837, 816, 817, 818, 819, 255,
-	839, 363, 831, 370, 368, 260, 135, 254, 821, 935,
-	135, 977, 659, 826, 827, 828, 63, 869, 870, 853,
-	854, 543, 297, 355, 20, 46, 249, 16, 15, 14,
-	865, 694, 770, 396, 867, 63, 13, 24, 12, 11,
-	10, 889, 872, 257, 9, 582, 726, 873, 8, 901,
-	888, 396, 876, 7, 875, 330, 896, 898, 6, 135,
-	5, 895, 4, 900, 240, 18, 63, 63, 2, 586,
-	725, 63, 63, 63, 0, 0, 63, 907, 908, 794,
-	744, 871, 891, 465, 443, 133, 904, 0, 230, 911,
-	0, 0, 0, 810, 0, 920, 0, 0, 0, 799,
-	63, 918, 0, 0, 0, 0, 0, 133, 133, 270,
-	0, 934, 0, 133, 0, 0, 508, 0, 0, 0,
-	726, 927, 38, 517, 924, 925, 0, 926, 941, 0,
-	928, 0, 930, 0, 0, 948, 942, 0, 0, 0,
-	956, 0, 0, 0, 725, 0, 0, 63, 0, 396,
-	396, 838, 396, 843, 0, 0, 958, 0, 63, 0,
-	0, 960, 0, 922, 923, 0, 268, 0, 966, 0,
-	0, 0, 0, 256, 0, 932, 933, 0, 0, 0,
-	63, 133, 63, 133, 579, 0, 866, 133, 0, 981,
-	0, 0, 944, 133, 946, 947, 0, 0, 258, 0,
-	0, 0, 0, 0, 0, 799, 0, 990, 396, 0,
-	0, 0, 0, 63, 0, 0, 0, 0, 999, 0,
-	0, 0, 961, 0, 0, 0, 1001, 0, 132, 967,
-	0, 0, 0, 0, 0, 0, 902, 903, 0, 0,
-	0, 906, 906, 906, 647, 648, 396, 654, 655, 0,
-	0, 261, 0, 0, 0, 0, 277, 19, 39, 21,
-	22, 983, 0, 0, 986, 0, 0, 0, 0, 0,
-	396, 0, 0, 0, 0, 33, 0, 0, 0, 0,
-	23, 0, 0, 0, 0, 0, 0, 0, 1004, 1005,
-	0, 0, 0, 508, 0, 0, 699, 700, 32, 0,
-	0, 41, 0, 0, 0, 0, 133, 0, 0, 0,
-	0, 0, 0, 133, 386, 133, 355, 843, 0, 0,
-	0, 0, 0, 0, 282, 0, 283, 0, 396, 0,
-	288, 0, 0, 0, 0, 133, 290, 133, 0, 0,
-	133, 0, 0, 133, 0, 456, 0, 269, 0, 0,
-	968, 0, 969, 0, 0, 0, 0, 0, 740, 0,
-	25, 26, 28, 27, 30, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 31, 34, 35, 0, 0, 36,
-	37, 29, 468, 985, 471, 0, 0, 0, 0, 0,
-	485, 486, 487, 488, 489, 490, 491, 0, 469, 470,
-	467, 473, 472, 482, 483, 475, 476, 477, 478, 479,
-	480, 481, 474, 0, 0, 484, 0, 0, 0, 0,
-	133, 0, 270, 133, 133, 133, 133, 293, 0, 0,
-	0, 0, 0, 0, 564, 0, 0, 0, 133, 0,
-	0, 386, 40, 0, 0, 133, 133, 0, 0, 365,
-	0, 0, 0, 0, 270, 0, 0, 456, 388, 0,
-	0, 0, 0, 473, 472, 482, 483, 475, 476, 477,
-	478, 479, 480, 481, 474, 0, 0, 484, 446, 803,
-	448, 0, 0, 451, 412, 0, 454, 0, 0, 814,
-	472, 482, 483, 475, 476, 477, 478, 479, 480, 481,
-	474, 133, 0, 484, 0, 0, 133, 424, 0, 133,
-	508, 0, 0, 0, 0, 832, 833, 429, 430, 431,
-	432, 433, 434, 435, 0, 436, 437, 438, 439, 440,
-	425, 426, 427, 428, 410, 411, 0, 0, 413, 0,
-	414, 415, 416, 417, 418, 419, 420, 421, 422, 423,
+	279, 307, 971, 1004, 515, 859, 312, 270, 735, 633,
+	244, 593, 619, 736, 450, 893, 581, 692, 699, 814,
+	702, 44, 921, 338, 775, 806, 63, 592, 732, 716,
+	142, 701, 148, 142, 669, 781, 604, 301, 310, 361,
+	367, 265, 238, 387, 404, 281, 589, 43, 1042, 391,
+	629, 274, 142, 142, 1030, 1039, 1023, 1036, 142, 452,
+	275, 376, 1029, 1022, 906, 958, 285, 999, 771, 612,
+	974, 139, 253, 620, 339, 38, 147, 239, 240, 241,
+	242, 48, 953, 982, 388, 3, 951, 1011, 759, 260,
+	769, 931, 932, 933, 268, 258, 607, 1002, 1001, 284,
+	934, 610, 50, 51, 52, 53, 1035, 1032, 1005, 796,
+	637, 997, 613, 38, 291, 292, 287, 133, 132, 793,
+	133, 249, 282, 243, 458, 795, 264, 259, 453, 750,
+	649, 314, 823, 142, 749, 142, 577, 579, 748, 142,
+	398, 894, 607, 283, 647, 142, 288, 144, 469, 468,
+	299, 1007, 481, 480, 490, 491, 483, 484, 485, 486,
+	487, 488, 489, 482, 896, 470, 492, 134, 762, 653,
+	135, 136, 137, 965, 289, 717, 290, 943, 646, 847,
+	295, 397, 818, 263, 606, 754, 297, 482, 298, 603,
+	492, 602, 898, 620, 902, 776, 897, 514, 895, 504,
+	505, 408, 824, 900, 998, 296, 996, 578, 492, 605,
+	871, 467, 899, 794, 407, 792, 470, 901, 903, 542,
+	543, 908, 1021, 455, 865, 935, 643, 648, 641, 717,
+	606, 830, 1014, 369, 527, 939, 481, 480, 490, 491,
+	483, 484, 485, 486, 487, 488, 489, 482, 651, 654,
+	492, 399, 294, 938, 766, 469, 468, 468, 142, 767,
+	872, 785, 676, 469, 468, 142, 142, 142, 300, 363,
+	63, 1008, 470, 470, 784, 807, 674, 675, 673, 645,
+	470, 469, 468, 469, 468, 63, 41, 142, 910, 142,
+	63, 772, 142, 644, 1025, 142, 672, 142, 470, 372,
+	470, 662, 664, 665, 457, 693, 663, 694, 395, 985,
+	300, 799, 800, 801, 937, 650, 825, 506, 507, 508,
+	509, 510, 511, 512, 38, 782, 652, 870, 454, 401,
+	456, 862, 365, 459, 364, 764, 462, 994, 1037, 389,
+	763, 465, 695, 463, 1018, 300, 481, 480, 490, 491,
+	483, 484, 485, 486, 487, 488, 489, 482, 449, 451,
+	492, 293, 469, 468, 451, 994, 993, 63, 967, 300,
+	926, 925, 142, 282, 544, 142, 142, 142, 142, 470,
+	275, 559, 300, 501, 503, 978, 142, 812, 300, 977,
+	142, 878, 877, 142, 874, 875, 263, 142, 142, 483,
+	484, 485, 486, 487, 488, 489, 482, 874, 873, 492,
+	63, 513, 275, 558, 517, 518, 519, 520, 521, 522,
+	523, 336, 526, 528, 528, 528, 528, 528, 528, 528,
+	528, 536, 537, 538, 539, 621, 622, 623, 546, 584,
+	583, 502, 580, 585, 867, 557, 571, 61, 373, 264,
+	264, 264, 264, 590, 142, 556, 597, 586, 635, 142,
+	846, 300, 142, 63, 389, 561, 707, 563, 588, 594,
+	374, 264, 707, 300, 575, 276, 668, 657, 405, 677,
+	678, 679, 680, 681, 682, 683, 684, 685, 686, 687,
+	688, 689, 690, 691, 582, 638, 631, 632, 45, 670,
+	655, 839, 560, 656, 562, 582, 263, 263, 263, 263,
+	374, 300, 733, 63, 405, 545, 378, 381, 382, 383,
+	379, 263, 380, 384, 410, 409, 744, 63, 263, 842,
+	615, 616, 617, 618, 374, 812, 374, 451, 573, 574,
+	876, 812, 812, 696, 697, 405, 626, 627, 628, 755,
+	709, 710, 19, 19, 713, 540, 721, 250, 63, 590,
+	41, 275, 734, 1031, 714, 614, 634, 928, 720, 758,
+	722, 723, 131, 930, 706, 554, 742, 555, 38, 737,
+	724, 630, 725, 731, 529, 530, 531, 532, 533, 534,
+	535, 884, 517, 63, 625, 624, 41, 41, 55, 19,
+	753, 41, 575, 751, 756, 786, 743, 671, 733, 461,
+	552, 481, 480, 490, 491, 483, 484, 485, 486, 487,
+	488, 489, 482, 257, 915, 492, 17, 746, 773, 774,
+	738, 472, 38, 745, 565, 564, 568, 1033, 63, 63,
+	761, 569, 739, 41, 1028, 566, 778, 779, 780, 704,
+	567, 798, 594, 788, 658, 368, 570, 63, 382, 383,
+	1027, 783, 254, 255, 730, 471, 708, 729, 366, 777,
+	406, 797, 864, 248, 1016, 1015, 803, 804, 805, 719,
+	913, 469, 468, 789, 485, 486, 487, 488, 489, 482,
+	302, 403, 492, 861, 808, 760, 840, 670, 470, 941,
+	639, 460, 303, 768, 386, 63, 403, 368, 802, 251,
+	252, 403, 245, 451, 481, 480, 490, 491, 483, 484,
+	485, 486, 487, 488, 489, 482, 747, 142, 492, 988,
+	246, 451, 811, 481, 480, 490, 491, 483, 484, 485,
+	486, 487, 488, 489, 482, 829, 827, 492, 45, 728,
+	987, 975, 961, 582, 962, 63, 63, 727, 63, 63,
+	466, 47, 854, 841, 848, 49, 396, 42, 837, 857,
+	490, 491, 483, 484, 485, 486, 487, 488, 489, 482,
+	1, 819, 492, 856, 642, 1003, 858, 142, 548, 600,
+	591, 142, 280, 54, 601, 276, 599, 63, 868, 869,
+	378, 381, 382, 383, 379, 671, 380, 384, 598, 885,
+	886, 995, 1000, 973, 855, 594, 63, 594, 765, 770,
+	611, 929, 1013, 851, 852, 853, 863, 276, 879, 883,
+	403, 403, 880, 888, 881, 889, 609, 608, 892, 413,
+	142, 905, 904, 866, 414, 412, 809, 63, 63, 907,
+	810, 416, 63, 63, 63, 911, 914, 63, 415, 920,
+	821, 822, 411, 590, 826, 891, 737, 145, 756, 832,
+	385, 833, 834, 835, 836, 923, 924, 390, 706, 400,
+	63, 813, 636, 912, 403, 56, 942, 791, 790, 843,
+	844, 845, 640, 286, 940, 304, 362, 480, 490, 491,
+	483, 484, 485, 486, 487, 488, 489, 482, 949, 500,
+	492, 726, 269, 740, 264, 541, 594, 738, 360, 986,
+	917, 960, 828, 524, 963, 715, 313, 63, 661, 63,
+	916, 325, 970, 322, 698, 324, 403, 323, 547, 737,
+	553, 474, 311, 305, 63, 576, 262, 337, 718, 370,
+	377, 375, 267, 261, 451, 838, 473, 957, 983, 1006,
+	981, 551, 887, 20, 46, 256, 63, 16, 63, 275,
+	989, 263, 15, 927, 14, 13, 276, 140, 24, 741,
+	237, 956, 12, 11, 10, 9, 8, 7, 6, 516,
+	738, 5, 38, 1010, 4, 63, 525, 247, 18, 140,
+	140, 277, 964, 855, 2, 140, 63, 946, 947, 0,
+	948, 0, 142, 950, 403, 952, 979, 0, 0, 0,
+	0, 0, 1026, 0, 19, 39, 21, 22, 0, 0,
+	0, 0, 63, 0, 1034, 0, 0, 0, 0, 0,
+	1040, 0, 33, 944, 945, 0, 0, 23, 0, 0,
+	0, 0, 0, 1024, 0, 954, 955, 587, 0, 787,
+	403, 0, 0, 0, 0, 32, 0, 0, 41, 0,
+	0, 0, 966, 0, 968, 969, 0, 0, 403, 0,
+	140, 0, 140, 0, 0, 0, 140, 976, 0, 0,
+	0, 0, 140, 327, 326, 329, 330, 331, 332, 0,
+	0, 0, 328, 333, 0, 0, 0, 0, 984, 0,
+	1038, 0, 0, 0, 0, 990, 0, 0, 659, 660,
+	0, 666, 667, 0, 0, 0, 816, 25, 26, 28,
+	27, 30, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 31, 34, 35, 0, 0, 36, 37, 29, 0,
+	0, 0, 0, 0, 1017, 0, 0, 1020, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 516, 0, 0,
+	711, 712, 0, 0, 0, 0, 403, 403, 0, 403,
+	860, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	362, 1043, 1044, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 140, 0, 0, 0, 0,
+	0, 0, 140, 393, 140, 0, 40, 0, 882, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 693, 456, 550, 0, 0, 693, 693, 0, 0,
-	693, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 868, 693, 693, 693, 693, 0, 576,
-	0, 0, 0, 0, 0, 0, 0, 893, 791, 693,
-	0, 0, 270, 473, 472, 482, 483, 475, 476, 477,
-	478, 479, 480, 481, 474, 0, 0, 484, 473, 472,
-	482, 483, 475, 476, 477, 478, 479, 480, 481, 474,
-	0, 0, 484, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 626, 0, 0, 0, 0, 643,
-	0, 0, 644, 0, 0, 0, 96, 0, 0, 0,
-	0, 0, 0, 0, 0, 77, 0, 0, 0, 0,
-	0, 85, 0, 0, 104, 92, 0, 0, 937, 473,
-	472, 482, 483, 475, 476, 477, 478, 479, 480, 481,
-	474, 0, 62, 484, 0, 0, 0, 0, 0, 0,
-	0, 72, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 752, 0, 140, 0, 140, 816, 0, 140,
+	403, 0, 140, 0, 464, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 473, 472, 482,
-	483, 475, 476, 477, 478, 479, 480, 481, 474, 0,
-	0, 484, 0, 0, 693, 0, 0, 0, 980, 508,
-	0, 0, 0, 0, 0, 136, 0, 0, 693, 0,
-	0, 99, 0, 0, 0, 73, 0, 103, 97, 0,
-	133, 98, 102, 86, 108, 66, 112, 106, 90, 81,
-	82, 65, 0, 101, 76, 80, 75, 95, 109, 110,
-	74, 122, 69, 117, 68, 70, 116, 94, 107, 113,
-	91, 88, 67, 111, 89, 87, 83, 78, 0, 0,
-	0, 105, 114, 123, 0, 0, 118, 119, 120, 93,
-	71, 0, 0, 0, 0, 133, 0, 0, 0, 133,
-	0, 0, 0, 0, 0, 0, 64, 0, 84, 121,
-	100, 79, 115, 0, 0, 0, 0, 0, 0, 0,
-	0, 693, 0, 0, 0, 0, 0, 456, 693, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 918, 919,
+	0, 0, 0, 922, 922, 922, 0, 0, 403, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 133, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 403, 0, 0, 0, 0, 431, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 140,
+	0, 277, 140, 140, 140, 140, 0, 0, 0, 0,
+	0, 0, 0, 572, 0, 0, 0, 140, 0, 0,
+	393, 0, 0, 0, 140, 140, 0, 0, 860, 0,
+	972, 0, 0, 277, 0, 0, 464, 820, 0, 0,
+	0, 0, 0, 0, 0, 403, 0, 831, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	419, 0, 0, 0, 276, 0, 0, 991, 516, 992,
+	0, 0, 0, 849, 850, 0, 0, 0, 0, 0,
+	0, 140, 0, 432, 0, 0, 140, 0, 0, 140,
+	0, 0, 0, 0, 0, 0, 1012, 0, 0, 0,
+	437, 438, 439, 440, 441, 442, 443, 1019, 444, 445,
+	446, 447, 448, 433, 434, 435, 436, 417, 418, 0,
+	0, 420, 0, 421, 422, 423, 424, 425, 426, 427,
+	428, 429, 430, 972, 0, 0, 0, 0, 0, 0,
+	100, 705, 464, 0, 0, 0, 705, 705, 0, 79,
+	705, 0, 0, 0, 0, 89, 0, 0, 109, 96,
+	909, 0, 0, 0, 705, 705, 705, 705, 0, 0,
+	0, 0, 0, 0, 0, 0, 62, 0, 0, 705,
+	0, 0, 277, 0, 0, 73, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 936, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 820, 0, 0, 0, 0, 0, 219,
-	209, 182, 221, 160, 174, 229, 175, 176, 203, 148,
-	190, 96, 172, 0, 163, 143, 169, 144, 161, 184,
-	77, 187, 159, 211, 193, 227, 85, 198, 0, 104,
-	92, 0, 0, 186, 213, 188, 208, 181, 204, 153,
-	197, 222, 173, 201, 0, 0, 0, 62, 863, 587,
-	588, 0, 864, 0, 0, 0, 72, 0, 200, 218,
-	171, 202, 142, 199, 0, 146, 149, 228, 216, 166,
-	167, 745, 0, 0, 0, 0, 270, 0, 185, 189,
-	205, 179, 0, 0, 0, 0, 0, 0, 0, 0,
-	164, 0, 196, 0, 0, 0, 150, 147, 0, 183,
-	0, 0, 0, 152, 0, 165, 206, 0, 214, 180,
-	136, 217, 178, 177, 220, 223, 99, 212, 162, 170,
-	73, 168, 103, 97, 0, 195, 98, 102, 86, 108,
-	66, 112, 106, 90, 81, 82, 65, 0, 101, 76,
-	80, 75, 95, 109, 110, 74, 122, 69, 117, 68,
-	70, 116, 94, 107, 113, 91, 88, 67, 111, 89,
-	87, 83, 78, 0, 145, 0, 105, 114, 123, 158,
-	215, 118, 119, 120, 93, 71, 156, 157, 154, 155,
-	191, 192, 224, 225, 226, 207, 151, 0, 0, 210,
-	194, 64, 0, 84, 121, 100, 79, 115, 219, 209,
-	182, 221, 160, 174, 229, 175, 176, 203, 148, 190,
-	96, 172, 0, 163, 143, 169, 144, 161, 184, 77,
-	187, 159, 211, 193, 227, 85, 198, 0, 104, 92,
-	0, 0, 186, 213, 188, 208, 181, 204, 153, 197,
-	222, 173, 201, 0, 0, 0, 62, 0, 587, 588,
-	0, 0, 0, 0, 0, 72, 0, 200, 218, 171,
-	202, 142, 199, 0, 146, 149, 228, 216, 166, 167,
-	0, 0, 0, 0, 0, 0, 0, 185, 189, 205,
-	179, 0, 0, 0, 0, 0, 0, 0, 0, 164,
-	0, 196, 0, 0, 0, 150, 147, 0, 183, 0,
-	0, 0, 152, 0, 165, 206, 0, 214, 180, 136,
-	217, 178, 177, 220, 223, 99, 212, 162, 170, 73,
-	168, 103, 97, 0, 195, 98, 102, 86, 108, 66,
-	112, 106, 90, 81, 82, 65, 0, 101, 76, 80,
-	75, 95, 109, 110, 74, 122, 69, 117, 68, 70,
-	116, 94, 107, 113, 91, 88, 67, 111, 89, 87,
-	83, 78, 0, 145, 0, 105, 114, 123, 158, 215,
-	118, 119, 120, 93, 71, 156, 157, 154, 155, 191,
-	192, 224, 225, 226, 207, 151, 0, 0, 210, 194,
-	64, 0, 84, 121, 100, 79, 115, 219, 209, 182,
-	221, 160, 174, 229, 175, 176, 203, 148, 190, 96,
-	172, 0, 163, 143, 169, 144, 161, 184, 77, 187,
-	159, 211, 193, 227, 85, 198, 0, 104, 92, 0,
-	0, 186, 213, 188, 208, 181, 204, 153, 197, 222,
-	173, 201, 0, 0, 0, 62, 0, 0, 0, 0,
-	0, 0, 0, 0, 72, 0, 200, 218, 171, 202,
-	142, 199, 0, 146, 149, 228, 216, 166, 167, 0,
-	0, 0, 0, 0, 0, 0, 185, 189, 205, 179,
-	0, 0, 0, 0, 0, 0, 957, 0, 164, 0,
-	196, 0, 0, 0, 150, 147, 0, 183, 0, 0,
-	0, 152, 0, 165, 206, 0, 214, 180, 136, 217,
-	178, 177, 220, 223, 99, 212, 162, 170, 73, 168,
-	103, 97, 0, 195, 98, 102, 86, 108, 66, 112,
-	106, 90, 81, 82, 65, 0, 101, 76, 80, 75,
-	95, 109, 110, 74, 122, 69, 117, 68, 70, 116,
-	94, 107, 113, 91, 88, 67, 111, 89, 87, 83,
-	78, 0, 145, 0, 105, 114, 123, 158, 215, 118,
-	119, 120, 93, 71, 156, 157, 154, 155, 191, 192,
-	224, 225, 226, 207, 151, 0, 0, 210, 194, 64,
-	0, 84, 121, 100, 79, 115, 219, 209, 182, 221,
-	160, 174, 229, 175, 176, 203, 148, 190, 96, 172,
-	0, 163, 143, 169, 144, 161, 184, 77, 187, 159,
-	211, 193, 227, 85, 198, 0, 104, 92, 0, 0,
-	186, 213, 188, 208, 181, 204, 153, 197, 222, 173,
-	201, 41, 0, 0, 62, 0, 0, 0, 0, 0,
-	0, 0, 0, 72, 0, 200, 218, 171, 202, 142,
-	199, 0, 146, 149, 228, 216, 166, 167, 0, 0,
-	0, 0, 0, 0, 0, 185, 189, 205, 179, 0,
-	0, 0, 0, 0, 0, 0, 0, 164, 0, 196,
-	0, 0, 0, 150, 147, 0, 183, 0, 0, 0,
-	152, 0, 165, 206, 0, 214, 180, 136, 217, 178,
-	177, 220, 223, 99, 212, 162, 170, 73, 168, 103,
-	97, 0, 195, 98, 102, 86, 108, 66, 112, 106,
-	90, 81, 82, 65, 0, 101, 76, 80, 75, 95,
-	109, 110, 74, 122, 69, 117, 68, 70, 116, 94,
-	107, 113, 91, 88, 67, 111, 89, 87, 83, 78,
-	0, 145, 0, 105, 114, 123, 158, 215, 118, 119,
-	120, 93, 71, 156, 157, 154, 155, 191, 192, 224,
-	225, 226, 207, 151, 0, 0, 210, 194, 64, 0,
-	84, 121, 100, 79, 115, 219, 209, 182, 221, 160,
-	174, 229, 175, 176, 203, 148, 190, 96, 172, 0,
-	163, 143, 169, 144, 161, 184, 77, 187, 159, 211,
-	193, 227, 85, 198, 0, 104, 92, 0, 0, 186,
-	213, 188, 208, 181, 204, 153, 197, 222, 173, 201,
-	0, 0, 0, 271, 0, 0, 0, 0, 0, 0,
-	0, 0, 72, 0, 200, 218, 171, 202, 142, 199,
-	0, 146, 149, 228, 216, 166, 167, 0, 0, 0,
-	0, 0, 0, 0, 185, 189, 205, 179, 0, 0,
-	0, 0, 0, 0, 874, 0, 164, 0, 196, 0,
-	0, 0, 150, 147, 0, 183, 0, 0, 0, 152,
-	0, 165, 206, 0, 214, 180, 136, 217, 178, 177,
-	220, 223, 99, 212, 162, 170, 73, 168, 103, 97,
-	0, 195, 98, 102, 86, 108, 66, 112, 106, 90,
-	81, 82, 65, 0, 101, 76, 80, 75, 95, 109,
-	110, 74, 122, 69, 117, 68, 70, 116, 94, 107,
-	113, 91, 88, 67, 111, 89, 87, 83, 78, 0,
-	145, 0, 105, 114, 123, 158, 215, 118, 119, 120,
-	93, 71, 156, 157, 154, 155, 191, 192, 224, 225,
-	226, 207, 151, 0, 0, 210, 194, 64, 0, 84,
-	121, 100, 79, 115, 219, 209, 182, 221, 160, 174,
-	229, 175, 176, 203, 148, 190, 96, 172, 0, 163,
-	143, 169, 144, 161, 184, 77, 187, 159, 211, 193,
-	227, 85, 198, 0, 104, 92, 0, 0, 186, 213,
-	188, 208, 181, 204, 153, 197, 222, 173, 201, 0,
-	0, 0, 62, 0, 395, 0, 0, 0, 0, 0,
-	0, 72, 0, 200, 218, 171, 202, 142, 199, 0,
-	146, 149, 228, 216, 166, 167, 0, 0, 0, 0,
-	0, 0, 0, 185, 189, 205, 179, 0, 0, 0,
-	0, 0, 0, 0, 0, 164, 0, 196, 0, 0,
-	0, 150, 147, 0, 183, 0, 0, 0, 152, 0,
-	165, 206, 0, 214, 180, 136, 217, 178, 177, 220,
-	223, 99, 212, 162, 170, 73, 168, 103, 97, 0,
-	195, 98, 102, 86, 108, 66, 112, 106, 90, 81,
-	82, 65, 0, 101, 76, 80, 75, 95, 109, 110,
-	74, 122, 69, 117, 68, 70, 116, 94, 107, 113,
-	91, 88, 67, 111, 89, 87, 83, 78, 0, 145,
-	0, 105, 114, 123, 158, 215, 118, 119, 120, 93,
-	71, 156, 157, 154, 155, 191, 192, 224, 225, 226,
-	207, 151, 0, 0, 210, 194, 64, 0, 84, 121,
-	100, 79, 115, 219, 209, 182, 221, 160, 174, 229,
-	175, 176, 203, 148, 190, 96, 172, 0, 163, 143,
-	169, 144, 161, 184, 77, 187, 159, 211, 193, 227,
-	85, 198, 0, 104, 92, 0, 0, 186, 213, 188,
-	208, 181, 204, 153, 197, 222, 173, 201, 0, 0,
-	0, 62, 0, 0, 0, 0, 0, 0, 0, 0,
-	72, 0, 200, 218, 171, 202, 142, 199, 0, 146,
-	149, 228, 216, 166, 167, 0, 0, 0, 0, 0,
-	0, 0, 185, 189, 205, 179, 0, 0, 0, 0,
-	0, 0, 0, 0, 164, 0, 196, 0, 0, 0,
-	150, 147, 0, 183, 0, 0, 0, 152, 0, 165,
-	206, 0, 214, 180, 136, 217, 178, 177, 220, 223,
-	99, 212, 162, 170, 73, 168, 103, 97, 0, 195,
-	98, 102, 86, 108, 66, 112, 106, 90, 81, 82,
-	65, 0, 101, 76, 80, 75, 95, 109, 110, 74,
-	122, 69, 117, 68, 70, 116, 94, 107, 113, 91,
-	88, 67, 111, 89, 87, 83, 78, 0, 145, 0,
-	105, 114, 123, 158, 215, 118, 119, 120, 93, 71,
-	156, 157, 154, 155, 191, 192, 224, 225, 226, 207,
-	151, 0, 0, 210, 194, 64, 0, 84, 121, 100,
-	79, 115, 219, 209, 182, 221, 160, 174, 229, 175,
-	176, 203, 148, 190, 96, 172, 0, 163, 143, 169,
-	144, 161, 184, 77, 187, 159, 211, 193, 227, 85,
-	198, 0, 104, 92, 0, 0, 186, 213, 188, 208,
-	181, 204, 153, 197, 222, 173, 201, 0, 0, 0,
-	271, 0, 0, 0, 0, 0, 0, 0, 0, 72,
-	0, 200, 218, 171, 202, 142, 199, 0, 146, 149,
-	228, 216, 166, 167, 0, 0, 0, 0, 0, 0,
-	0, 185, 189, 205, 179, 0, 0, 0, 0, 0,
-	0, 0, 0, 164, 0, 196, 0, 0, 0, 150,
-	147, 0, 183, 0, 0, 0, 152, 0, 165, 206,
-	0, 214, 180, 136, 217, 178, 177, 220, 223, 99,
-	212, 162, 170, 73, 168, 103, 97, 0, 195, 98,
-	102, 86, 108, 66, 112, 106, 90, 81, 82, 65,
-	0, 101, 76, 80, 75, 95, 109, 110, 74, 122,
-	69, 117, 68, 70, 116, 94, 107, 113, 91, 88,
-	67, 111, 89, 87, 83, 78, 0, 145, 0, 105,
-	114, 123, 158, 215, 118, 119, 120, 93, 71, 156,
-	157, 154, 155, 191, 192, 224, 225, 226, 207, 151,
-	0, 0, 210, 194, 64, 0, 84, 121, 100, 79,
-	115, 219, 209, 182, 221, 160, 174, 229, 175, 176,
-	203, 148, 190, 96, 172, 0, 163, 143, 169, 144,
-	161, 184, 77, 187, 159, 211, 193, 227, 85, 198,
-	0, 104, 92, 0, 0, 186, 213, 188, 208, 181,
-	204, 153, 197, 222, 173, 201, 0, 0, 0, 134,
-	0, 0, 0, 0, 0, 0, 0, 0, 72, 0,
-	200, 218, 171, 202, 142, 199, 0, 146, 149, 228,
-	216, 166, 167, 0, 0, 0, 0, 0, 0, 0,
-	185, 189, 205, 179, 0, 0, 0, 0, 0, 0,
-	0, 0, 164, 0, 196, 0, 0, 0, 150, 147,
-	0, 183, 0, 0, 0, 152, 0, 165, 206, 0,
-	214, 180, 136, 217, 178, 177, 220, 223, 99, 212,
-	162, 170, 73, 168, 103, 97, 0, 195, 98, 102,
-	86, 108, 66, 112, 106, 90, 81, 82, 65, 0,
-	101, 76, 80, 75, 95, 109, 110, 74, 122, 69,
-	117, 68, 70, 116, 94, 107, 113, 91, 88, 67,
-	111, 89, 87, 83, 78, 0, 145, 0, 105, 114,
-	123, 158, 215, 118, 119, 120, 93, 71, 156, 157,
-	154, 155, 191, 192, 224, 225, 226, 207, 151, 0,
-	0, 210, 194, 64, 0, 84, 121, 100, 79, 115,
-	219, 209, 182, 221, 160, 174, 229, 175, 176, 203,
-	148, 190, 96, 172, 0, 163, 143, 169, 144, 161,
-	184, 77, 187, 159, 211, 193, 227, 85, 198, 0,
-	104, 92, 0, 0, 186, 213, 188, 208, 181, 204,
-	153, 197, 222, 173, 201, 0, 0, 0, 139, 0,
-	0, 0, 0, 0, 0, 0, 0, 72, 0, 200,
-	218, 171, 202, 142, 199, 0, 146, 149, 228, 216,
-	166, 167, 0, 0, 0, 0, 0, 0, 0, 185,
-	189, 205, 179, 0, 0, 0, 0, 0, 0, 0,
-	0, 164, 0, 196, 0, 0, 0, 150, 147, 0,
-	183, 0, 0, 0, 152, 0, 165, 206, 0, 214,
-	180, 136, 217, 178, 177, 220, 223, 99, 212, 162,
-	170, 73, 168, 103, 97, 0, 195, 98, 102, 86,
-	108, 66, 112, 106, 90, 81, 82, 65, 0, 101,
-	76, 80, 75, 95, 109, 110, 74, 122, 69, 117,
-	68, 70, 116, 94, 107, 113, 91, 88, 67, 111,
-	89, 87, 83, 78, 0, 145, 0, 105, 114, 123,
-	158, 215, 118, 119, 120, 93, 71, 156, 157, 154,
-	155, 191, 192, 224, 225, 226, 207, 151, 0, 0,
-	210, 194, 64, 0, 84, 121, 100, 79, 115, 96,
-	0, 0, 688, 0, 302, 0, 0, 0, 77, 0,
-	301, 0, 0, 340, 85, 0, 0, 104, 92, 0,
-	0, 0, 0, 333, 334, 0, 0, 0, 0, 0,
-	0, 0, 41, 0, 0, 271, 320, 319, 322, 323,
-	324, 325, 0, 0, 72, 321, 326, 327, 328, 0,
-	0, 299, 313, 0, 339, 0, 0, 0, 0, 0,
+	0, 481, 480, 490, 491, 483, 484, 485, 486, 487,
+	488, 489, 482, 0, 0, 492, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
+	959, 0, 0, 0, 0, 103, 0, 0, 0, 75,
+	0, 108, 101, 0, 0, 102, 107, 90, 114, 84,
+	65, 112, 124, 74, 83, 106, 67, 118, 111, 94,
+	85, 86, 66, 0, 105, 78, 82, 77, 99, 115,
+	116, 76, 129, 70, 123, 69, 71, 122, 98, 113,
+	119, 95, 92, 68, 117, 93, 91, 87, 80, 0,
+	0, 0, 110, 120, 130, 0, 0, 125, 126, 127,
+	97, 72, 0, 0, 0, 0, 0, 1009, 516, 0,
+	0, 0, 0, 0, 0, 0, 0, 64, 705, 88,
+	128, 104, 81, 121, 0, 0, 0, 0, 0, 0,
+	0, 0, 705, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 140, 0, 0, 0, 0, 0,
+	0, 0, 226, 216, 189, 228, 167, 181, 236, 182,
+	183, 210, 155, 197, 100, 179, 0, 170, 150, 176,
+	151, 168, 191, 79, 194, 166, 218, 200, 234, 89,
+	205, 0, 109, 96, 0, 0, 193, 220, 195, 215,
+	188, 211, 160, 204, 229, 180, 208, 0, 0, 0,
+	62, 0, 595, 596, 140, 0, 0, 0, 140, 73,
+	0, 207, 225, 178, 209, 149, 206, 0, 153, 156,
+	235, 223, 173, 174, 757, 0, 0, 0, 0, 0,
+	705, 192, 196, 212, 186, 0, 464, 705, 0, 0,
+	0, 0, 0, 171, 0, 203, 0, 0, 0, 157,
+	154, 0, 190, 0, 0, 0, 159, 140, 172, 213,
+	0, 221, 187, 143, 224, 185, 184, 227, 230, 103,
+	219, 169, 177, 75, 175, 108, 101, 0, 202, 102,
+	107, 90, 114, 84, 65, 112, 124, 74, 83, 106,
+	67, 118, 111, 94, 85, 86, 66, 0, 105, 78,
+	82, 77, 99, 115, 116, 76, 129, 70, 123, 69,
+	71, 122, 98, 113, 119, 95, 92, 68, 117, 93,
+	91, 87, 80, 0, 152, 0, 110, 120, 130, 165,
+	222, 125, 126, 127, 97, 72, 163, 164, 161, 162,
+	198, 199, 231, 232, 233, 214, 158, 0, 0, 217,
+	201, 64, 0, 88, 128, 104, 81, 121, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 310, 311, 691, 0, 0, 0,
-	351, 0, 312, 0, 0, 308, 309, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 136, 0,
-	0, 349, 0, 0, 99, 0, 0, 0, 73, 0,
-	103, 97, 0, 0, 98, 102, 86, 108, 66, 112,
-	106, 90, 81, 82, 65, 0, 101, 76, 80, 75,
-	95, 109, 110, 74, 122, 69, 117, 68, 70, 116,
-	94, 107, 113, 91, 88, 67, 111, 89, 87, 83,
-	78, 0, 0, 0, 105, 114, 123, 0, 0, 118,
-	119, 120, 93, 71, 341, 350, 347, 348, 345, 346,
-	344, 343, 342, 352, 335, 336, 338, 0, 337, 64,
-	0, 84, 121, 100, 79, 115, 96, 0, 0, 0,
-	0, 302, 0, 0, 0, 77, 0, 301, 0, 0,
-	340, 85, 0, 0, 104, 92, 0, 0, 0, 0,
-	333, 334, 0, 0, 0, 0, 0, 0, 0, 41,
-	0, 0, 271, 320, 319, 322, 323, 324, 325, 0,
-	0, 72, 321, 326, 327, 328, 0, 0, 299, 313,
-	0, 339, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 310, 311, 691, 0, 0, 0, 351, 0, 312,
-	0, 0, 308, 309, 314, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 136, 0, 0, 349, 0,
-	0, 99, 0, 0, 0, 73, 0, 103, 97, 0,
-	0, 98, 102, 86, 108, 66, 112, 106, 90, 81,
-	82, 65, 0, 101, 76, 80, 75, 95, 109, 110,
-	74, 122, 69, 117, 68, 70, 116, 94, 107, 113,
-	91, 88, 67, 111, 89, 87, 83, 78, 0, 0,
-	0, 105, 114, 123, 0, 0, 118, 119, 120, 93,
-	71, 341, 350, 347, 348, 345, 346, 344, 343, 342,
-	352, 335, 336, 338, 0, 337, 64, 0, 84, 121,
-	100, 79, 115, 96, 0, 0, 0, 0, 302, 0,
-	0, 0, 77, 0, 301, 0, 0, 340, 85, 0,
-	0, 104, 92, 0, 0, 0, 0, 333, 334, 0,
-	0, 0, 0, 0, 0, 0, 41, 0, 293, 271,
-	320, 319, 322, 323, 324, 325, 0, 0, 72, 321,
-	326, 327, 328, 0, 0, 299, 313, 0, 339, 0,
+	277, 0, 226, 216, 189, 228, 167, 181, 236, 182,
+	183, 210, 155, 197, 100, 179, 0, 170, 150, 176,
+	151, 168, 191, 79, 194, 166, 218, 200, 234, 89,
+	205, 0, 109, 96, 0, 0, 193, 220, 195, 215,
+	188, 211, 160, 204, 229, 180, 208, 0, 0, 140,
+	62, 0, 595, 596, 0, 0, 0, 0, 0, 73,
+	0, 207, 225, 178, 209, 149, 206, 0, 153, 156,
+	235, 223, 173, 174, 0, 0, 0, 0, 0, 0,
+	0, 192, 196, 212, 186, 0, 0, 0, 0, 0,
+	0, 0, 0, 171, 0, 203, 0, 0, 0, 157,
+	154, 0, 190, 0, 0, 0, 159, 0, 172, 213,
+	0, 221, 187, 143, 224, 185, 184, 227, 230, 103,
+	219, 169, 177, 75, 175, 108, 101, 0, 202, 102,
+	107, 90, 114, 84, 65, 112, 124, 74, 83, 106,
+	67, 118, 111, 94, 85, 86, 66, 0, 105, 78,
+	82, 77, 99, 115, 116, 76, 129, 70, 123, 69,
+	71, 122, 98, 113, 119, 95, 92, 68, 117, 93,
+	91, 87, 80, 0, 152, 0, 110, 120, 130, 165,
+	222, 125, 126, 127, 97, 72, 163, 164, 161, 162,
+	198, 199, 231, 232, 233, 214, 158, 0, 0, 217,
+	201, 64, 0, 88, 128, 104, 81, 121, 226, 216,
+	189, 228, 167, 181, 236, 182, 183, 210, 155, 197,
+	100, 179, 0, 170, 150, 176, 151, 168, 191, 79,
+	194, 166, 218, 200, 234, 89, 205, 0, 109, 96,
+	0, 0, 193, 220, 195, 215, 188, 211, 160, 204,
+	229, 180, 208, 0, 0, 0, 62, 0, 0, 0,
+	0, 0, 0, 0, 0, 73, 0, 207, 225, 178,
+	209, 149, 206, 0, 153, 156, 235, 223, 173, 174,
+	0, 0, 0, 0, 0, 0, 0, 192, 196, 212,
+	186, 0, 0, 0, 0, 0, 0, 980, 0, 171,
+	0, 203, 0, 0, 0, 157, 154, 0, 190, 0,
+	0, 0, 159, 0, 172, 213, 0, 221, 187, 143,
+	224, 185, 184, 227, 230, 103, 219, 169, 177, 75,
+	175, 108, 101, 0, 202, 102, 107, 90, 114, 84,
+	65, 112, 124, 74, 83, 106, 67, 118, 111, 94,
+	85, 86, 66, 0, 105, 78, 82, 77, 99, 115,
+	116, 76, 129, 70, 123, 69, 71, 122, 98, 113,
+	119, 95, 92, 68, 117, 93, 91, 87, 80, 0,
+	152, 0, 110, 120, 130, 165, 222, 125, 126, 127,
+	97, 72, 163, 164, 161, 162, 198, 199, 231, 232,
+	233, 214, 158, 0, 0, 217, 201, 64, 0, 88,
+	128, 104, 81, 121, 226, 216, 189, 228, 167, 181,
+	236, 182, 183, 210, 155, 197, 100, 179, 0, 170,
+	150, 176, 151, 168, 191, 79, 194, 166, 218, 200,
+	234, 89, 205, 0, 109, 96, 0, 0, 193, 220,
+	195, 215, 188, 211, 160, 204, 229, 180, 208, 41,
+	0, 0, 62, 0, 0, 0, 0, 0, 0, 0,
+	0, 73, 0, 207, 225, 178, 209, 149, 206, 0,
+	153, 156, 235, 223, 173, 174, 0, 0, 0, 0,
+	0, 0, 0, 192, 196, 212, 186, 0, 0, 0,
+	0, 0, 0, 0, 0, 171, 0, 203, 0, 0,
+	0, 157, 154, 0, 190, 0, 0, 0, 159, 0,
+	172, 213, 0, 221, 187, 143, 224, 185, 184, 227,
+	230, 103, 219, 169, 177, 75, 175, 108, 101, 0,
+	202, 102, 107, 90, 114, 84, 65, 112, 124, 74,
+	83, 106, 67, 118, 111, 94, 85, 86, 66, 0,
+	105, 78, 82, 77, 99, 115, 116, 76, 129, 70,
+	123, 69, 71, 122, 98, 113, 119, 95, 92, 68,
+	117, 93, 91, 87, 80, 0, 152, 0, 110, 120,
+	130, 165, 222, 125, 126, 127, 97, 72, 163, 164,
+	161, 162, 198, 199, 231, 232, 233, 214, 158, 0,
+	0, 217, 201, 64, 0, 88, 128, 104, 81, 121,
+	226, 216, 189, 228, 167, 181, 236, 182, 183, 210,
+	155, 197, 100, 179, 0, 170, 150, 176, 151, 168,
+	191, 79, 194, 166, 218, 200, 234, 89, 205, 0,
+	109, 96, 0, 0, 193, 220, 195, 215, 188, 211,
+	160, 204, 229, 180, 208, 0, 0, 0, 278, 0,
+	0, 0, 0, 0, 0, 0, 0, 73, 0, 207,
+	225, 178, 209, 149, 206, 0, 153, 156, 235, 223,
+	173, 174, 0, 0, 0, 0, 0, 0, 0, 192,
+	196, 212, 186, 0, 0, 0, 0, 0, 0, 890,
+	0, 171, 0, 203, 0, 0, 0, 157, 154, 0,
+	190, 0, 0, 0, 159, 0, 172, 213, 0, 221,
+	187, 143, 224, 185, 184, 227, 230, 103, 219, 169,
+	177, 75, 175, 108, 101, 0, 202, 102, 107, 90,
+	114, 84, 65, 112, 124, 74, 83, 106, 67, 118,
+	111, 94, 85, 86, 66, 0, 105, 78, 82, 77,
+	99, 115, 116, 76, 129, 70, 123, 69, 71, 122,
+	98, 113, 119, 95, 92, 68, 117, 93, 91, 87,
+	80, 0, 152, 0, 110, 120, 130, 165, 222, 125,
+	126, 127, 97, 72, 163, 164, 161, 162, 198, 199,
+	231, 232, 233, 214, 158, 0, 0, 217, 201, 64,
+	0, 88, 128, 104, 81, 121, 226, 216, 189, 228,
+	167, 181, 236, 182, 183, 210, 155, 197, 100, 179,
+	0, 170, 150, 176, 151, 168, 191, 79, 194, 166,
+	218, 200, 234, 89, 205, 0, 109, 96, 0, 0,
+	193, 220, 195, 215, 188, 211, 160, 204, 229, 180,
+	208, 0, 0, 0, 62, 0, 402, 0, 0, 0,
+	0, 0, 0, 73, 0, 207, 225, 178, 209, 149,
+	206, 0, 153, 156, 235, 223, 173, 174, 0, 0,
+	0, 0, 0, 0, 0, 192, 196, 212, 186, 0,
+	0, 0, 0, 0, 0, 0, 0, 171, 0, 203,
+	0, 0, 0, 157, 154, 0, 190, 0, 0, 0,
+	159, 0, 172, 213, 0, 221, 187, 143, 224, 185,
+	184, 227, 230, 103, 219, 169, 177, 75, 175, 108,
+	101, 0, 202, 102, 107, 90, 114, 84, 65, 112,
+	124, 74, 83, 106, 67, 118, 111, 94, 85, 86,
+	66, 0, 105, 78, 82, 77, 99, 115, 116, 76,
+	129, 70, 123, 69, 71, 122, 98, 113, 119, 95,
+	92, 68, 117, 93, 91, 87, 80, 0, 152, 0,
+	110, 120, 130, 165, 222, 125, 126, 127, 97, 72,
+	163, 164, 161, 162, 198, 199, 231, 232, 233, 214,
+	158, 0, 0, 217, 201, 64, 0, 88, 128, 104,
+	81, 121, 226, 216, 189, 228, 167, 181, 236, 182,
+	183, 210, 155, 197, 100, 179, 0, 170, 150, 176,
+	151, 168, 191, 79, 194, 166, 218, 200, 234, 89,
+	205, 0, 109, 96, 0, 0, 193, 220, 195, 215,
+	188, 211, 160, 204, 229, 180, 208, 0, 0, 0,
+	62, 0, 0, 0, 0, 0, 0, 0, 0, 73,
+	0, 207, 225, 178, 209, 149, 206, 0, 153, 156,
+	235, 223, 173, 174, 0, 0, 0, 0, 0, 0,
+	0, 192, 196, 212, 186, 0, 0, 0, 0, 0,
+	0, 0, 0, 171, 0, 203, 0, 0, 0, 157,
+	154, 0, 190, 0, 0, 0, 159, 0, 172, 213,
+	0, 221, 187, 143, 224, 185, 184, 227, 230, 103,
+	219, 169, 177, 75, 175, 108, 101, 0, 202, 102,
+	107, 90, 114, 84, 65, 112, 124, 74, 83, 106,
+	67, 118, 111, 94, 85, 86, 66, 0, 105, 78,
+	82, 77, 99, 115, 116, 76, 129, 70, 123, 69,
+	71, 122, 98, 113, 119, 95, 92, 68, 117, 93,
+	91, 87, 80, 0, 152, 0, 110, 120, 130, 165,
+	222, 125, 126, 127, 97, 72, 163, 164, 161, 162,
+	198, 199, 231, 232, 233, 214, 158, 0, 0, 217,
+	201, 64, 0, 88, 128, 104, 81, 121, 226, 216,
+	189, 228, 167, 181, 236, 182, 183, 210, 155, 197,
+	100, 179, 0, 170, 150, 176, 151, 168, 191, 79,
+	194, 166, 218, 200, 234, 89, 205, 0, 109, 96,
+	0, 0, 193, 220, 195, 215, 188, 211, 160, 204,
+	229, 180, 208, 0, 0, 0, 278, 0, 0, 0,
+	0, 0, 0, 0, 0, 73, 0, 207, 225, 178,
+	209, 149, 206, 0, 153, 156, 235, 223, 173, 174,
+	0, 0, 0, 0, 0, 0, 0, 192, 196, 212,
+	186, 0, 0, 0, 0, 0, 0, 0, 0, 171,
+	0, 203, 0, 0, 0, 157, 154, 0, 190, 0,
+	0, 0, 159, 0, 172, 213, 0, 221, 187, 143,
+	224, 185, 184, 227, 230, 103, 219, 169, 177, 75,
+	175, 108, 101, 0, 202, 102, 107, 90, 114, 84,
+	65, 112, 124, 74, 83, 106, 67, 118, 111, 94,
+	85, 86, 66, 0, 105, 78, 82, 77, 99, 115,
+	116, 76, 129, 70, 123, 69, 71, 122, 98, 113,
+	119, 95, 92, 68, 117, 93, 91, 87, 80, 0,
+	152, 0, 110, 120, 130, 165, 222, 125, 126, 127,
+	97, 72, 163, 164, 161, 162, 198, 199, 231, 232,
+	233, 214, 158, 0, 0, 217, 201, 64, 0, 88,
+	128, 104, 81, 121, 226, 216, 189, 228, 167, 181,
+	236, 182, 183, 210, 155, 197, 100, 179, 0, 170,
+	150, 176, 151, 168, 191, 79, 194, 166, 218, 200,
+	234, 89, 205, 0, 109, 96, 0, 0, 193, 220,
+	195, 215, 188, 211, 160, 204, 229, 180, 208, 0,
+	0, 0, 141, 0, 0, 0, 0, 0, 0, 0,
+	0, 73, 0, 207, 225, 178, 209, 149, 206, 0,
+	153, 156, 235, 223, 173, 174, 0, 0, 0, 0,
+	0, 0, 0, 192, 196, 212, 186, 0, 0, 0,
+	0, 0, 0, 0, 0, 171, 0, 203, 0, 0,
+	0, 157, 154, 0, 190, 0, 0, 0, 159, 0,
+	172, 213, 0, 221, 187, 143, 224, 185, 184, 227,
+	230, 103, 219, 169, 177, 75, 175, 108, 101, 0,
+	202, 102, 107, 90, 114, 84, 65, 112, 124, 74,
+	83, 106, 67, 118, 111, 94, 85, 86, 66, 0,
+	105, 78, 82, 77, 99, 115, 116, 76, 129, 70,
+	123, 69, 71, 122, 98, 113, 119, 95, 92, 68,
+	117, 93, 91, 87, 80, 0, 152, 0, 110, 120,
+	130, 165, 222, 125, 126, 127, 97, 72, 163, 164,
+	161, 162, 198, 199, 231, 232, 233, 214, 158, 0,
+	0, 217, 201, 64, 0, 88, 128, 104, 81, 121,
+	226, 216, 189, 228, 167, 181, 236, 182, 183, 210,
+	155, 197, 100, 179, 0, 170, 150, 176, 151, 168,
+	191, 79, 194, 166, 218, 200, 234, 89, 205, 0,
+	109, 96, 0, 0, 193, 220, 195, 215, 188, 211,
+	160, 204, 229, 180, 208, 0, 0, 0, 146, 0,
+	0, 0, 0, 0, 0, 0, 0, 73, 0, 207,
+	225, 178, 209, 149, 206, 0, 153, 156, 235, 223,
+	173, 174, 0, 0, 0, 0, 0, 0, 0, 192,
+	196, 212, 186, 0, 0, 0, 0, 0, 0, 0,
+	0, 171, 0, 203, 0, 0, 0, 157, 154, 0,
+	190, 0, 0, 0, 159, 0, 172, 213, 0, 221,
+	187, 143, 224, 185, 184, 227, 230, 103, 219, 169,
+	177, 75, 175, 108, 101, 0, 202, 102, 107, 90,
+	114, 84, 65, 112, 124, 74, 83, 106, 67, 118,
+	111, 94, 85, 86, 66, 0, 105, 78, 82, 77,
+	99, 115, 116, 76, 129, 70, 123, 69, 71, 122,
+	98, 113, 119, 95, 92, 68, 117, 93, 91, 87,
+	80, 0, 152, 0, 110, 120, 130, 165, 222, 125,
+	126, 127, 97, 72, 163, 164, 161, 162, 198, 199,
+	231, 232, 233, 214, 158, 0, 0, 217, 201, 64,
+	0, 88, 128, 104, 81, 121, 100, 0, 0, 700,
+	0, 309, 0, 0, 0, 79, 0, 308, 0, 0,
+	347, 89, 0, 0, 109, 96, 0, 0, 0, 0,
+	340, 341, 0, 0, 0, 0, 0, 0, 0, 41,
+	0, 0, 278, 327, 326, 329, 330, 331, 332, 0,
+	0, 73, 328, 333, 334, 335, 0, 0, 306, 320,
+	0, 346, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 310, 311,
-	0, 0, 0, 0, 351, 0, 312, 0, 0, 308,
-	309, 314, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 136, 0, 0, 349, 0, 0, 99, 0,
-	0, 0, 73, 0, 103, 97, 0, 0, 98, 102,
-	86, 108, 66, 112, 106, 90, 81, 82, 65, 0,
-	101, 76, 80, 75, 95, 109, 110, 74, 122, 69,
-	117, 68, 70, 116, 94, 107, 113, 91, 88, 67,
-	111, 89, 87, 83, 78, 0, 0, 0, 105, 114,
-	123, 0, 0, 118, 119, 120, 93, 71, 341, 350,
-	347, 348, 345, 346, 344, 343, 342, 352, 335, 336,
-	338, 19, 337, 64, 0, 84, 121, 100, 79, 115,
-	0, 0, 96, 0, 0, 0, 0, 302, 0, 0,
-	0, 77, 0, 301, 0, 0, 340, 85, 0, 0,
-	104, 92, 0, 0, 0, 0, 333, 334, 0, 0,
-	0, 0, 0, 0, 0, 41, 0, 0, 271, 320,
-	319, 322, 323, 324, 325, 0, 0, 72, 321, 326,
-	327, 328, 0, 0, 299, 313, 0, 339, 0, 0,
+	0, 317, 318, 703, 0, 0, 0, 358, 0, 319,
+	0, 0, 315, 316, 321, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 143, 0, 0, 356, 0,
+	0, 103, 0, 0, 0, 75, 0, 108, 101, 0,
+	0, 102, 107, 90, 114, 84, 65, 112, 124, 74,
+	83, 106, 67, 118, 111, 94, 85, 86, 66, 0,
+	105, 78, 82, 77, 99, 115, 116, 76, 129, 70,
+	123, 69, 71, 122, 98, 113, 119, 95, 92, 68,
+	117, 93, 91, 87, 80, 0, 0, 0, 110, 120,
+	130, 0, 0, 125, 126, 127, 97, 72, 348, 357,
+	354, 355, 352, 353, 351, 350, 349, 359, 342, 343,
+	345, 0, 344, 64, 0, 88, 128, 104, 81, 121,
+	100, 0, 0, 0, 0, 309, 0, 0, 0, 79,
+	0, 308, 0, 0, 347, 89, 0, 0, 109, 96,
+	0, 0, 0, 0, 340, 341, 0, 0, 0, 0,
+	0, 0, 0, 41, 0, 0, 278, 327, 326, 329,
+	330, 331, 332, 0, 0, 73, 328, 333, 334, 335,
+	0, 0, 306, 320, 0, 346, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 310, 311, 0,
-	0, 0, 0, 351, 0, 312, 0, 0, 308, 309,
-	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 136, 0, 0, 349, 0, 0, 99, 0, 0,
-	0, 73, 0, 103, 97, 0, 0, 98, 102, 86,
-	108, 66, 112, 106, 90, 81, 82, 65, 0, 101,
-	76, 80, 75, 95, 109, 110, 74, 122, 69, 117,
-	68, 70, 116, 94, 107, 113, 91, 88, 67, 111,
-	89, 87, 83, 78, 0, 0, 0, 105, 114, 123,
-	0, 0, 118, 119, 120, 93, 71, 341, 350, 347,
-	348, 345, 346, 344, 343, 342, 352, 335, 336, 338,
-	0, 337, 64, 0, 84, 121, 100, 79, 115, 96,
-	0, 0, 0, 0, 302, 0, 0, 0, 77, 0,
-	301, 0, 0, 340, 85, 0, 0, 104, 92, 0,
-	0, 0, 0, 333, 334, 0, 0, 0, 0, 0,
-	0, 0, 41, 0, 0, 271, 320, 319, 322, 323,
-	324, 325, 0, 0, 72, 321, 326, 327, 328, 0,
-	0, 299, 313, 0, 339, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 317, 318, 703, 0, 0,
+	0, 358, 0, 319, 0, 0, 315, 316, 321, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
+	0, 0, 356, 0, 0, 103, 0, 0, 0, 75,
+	0, 108, 101, 0, 0, 102, 107, 90, 114, 84,
+	65, 112, 124, 74, 83, 106, 67, 118, 111, 94,
+	85, 86, 66, 0, 105, 78, 82, 77, 99, 115,
+	116, 76, 129, 70, 123, 69, 71, 122, 98, 113,
+	119, 95, 92, 68, 117, 93, 91, 87, 80, 0,
+	0, 0, 110, 120, 130, 0, 0, 125, 126, 127,
+	97, 72, 348, 357, 354, 355, 352, 353, 351, 350,
+	349, 359, 342, 343, 345, 0, 344, 64, 0, 88,
+	128, 104, 81, 121, 100, 0, 0, 0, 0, 309,
+	0, 0, 0, 79, 0, 308, 0, 0, 347, 89,
+	0, 0, 109, 96, 0, 0, 0, 0, 340, 341,
+	0, 0, 0, 0, 0, 0, 0, 41, 0, 300,
+	278, 327, 326, 329, 330, 331, 332, 0, 0, 73,
+	328, 333, 334, 335, 0, 0, 306, 320, 0, 346,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 310, 311, 0, 0, 0, 0,
-	351, 0, 312, 0, 0, 308, 309, 314, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 136, 0,
-	0, 349, 0, 0, 99, 0, 0, 0, 73, 0,
-	103, 97, 0, 0, 98, 102, 86, 108, 66, 112,
-	106, 90, 81, 82, 65, 0, 101, 76, 80, 75,
-	95, 109, 110, 74, 122, 69, 117, 68, 70, 116,
-	94, 107, 113, 91, 88, 67, 111, 89, 87, 83,
-	78, 0, 0, 0, 105, 114, 123, 0, 0, 118,
-	119, 120, 93, 71, 341, 350, 347, 348, 345, 346,
-	344, 343, 342, 352, 335, 336, 338, 96, 337, 64,
-	0, 84, 121, 100, 79, 115, 77, 0, 0, 0,
-	0, 340, 85, 0, 0, 104, 92, 0, 0, 0,
-	0, 333, 334, 0, 0, 0, 0, 0, 0, 0,
-	41, 0, 0, 271, 320, 319, 322, 323, 324, 325,
-	0, 0, 72, 321, 326, 327, 328, 0, 0, 0,
-	313, 0, 339, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
+	318, 0, 0, 0, 0, 358, 0, 319, 0, 0,
+	315, 316, 321, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 143, 0, 0, 356, 0, 0, 103,
+	0, 0, 0, 75, 0, 108, 101, 0, 0, 102,
+	107, 90, 114, 84, 65, 112, 124, 74, 83, 106,
+	67, 118, 111, 94, 85, 86, 66, 0, 105, 78,
+	82, 77, 99, 115, 116, 76, 129, 70, 123, 69,
+	71, 122, 98, 113, 119, 95, 92, 68, 117, 93,
+	91, 87, 80, 0, 0, 0, 110, 120, 130, 0,
+	0, 125, 126, 127, 97, 72, 348, 357, 354, 355,
+	352, 353, 351, 350, 349, 359, 342, 343, 345, 19,
+	344, 64, 0, 88, 128, 104, 81, 121, 0, 0,
+	100, 0, 0, 0, 0, 309, 0, 0, 0, 79,
+	0, 308, 0, 0, 347, 89, 0, 0, 109, 96,
+	0, 0, 0, 0, 340, 341, 0, 0, 0, 0,
+	0, 0, 0, 41, 0, 0, 278, 327, 326, 329,
+	330, 331, 332, 0, 0, 73, 328, 333, 334, 335,
+	0, 0, 306, 320, 0, 346, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 310, 311, 0, 0, 0, 0, 351, 0,
-	312, 0, 0, 308, 309, 314, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 136, 0, 0, 349,
-	0, 0, 99, 0, 0, 0, 73, 0, 103, 97,
-	0, 1002, 98, 102, 86, 108, 66, 112, 106, 90,
-	81, 82, 65, 0, 101, 76, 80, 75, 95, 109,
-	110, 74, 122, 69, 117, 68, 70, 116, 94, 107,
-	113, 91, 88, 67, 111, 89, 87, 83, 78, 0,
-	0, 0, 105, 114, 123, 0, 0, 118, 119, 120,
-	93, 71, 341, 350, 347, 348, 345, 346, 344, 343,
-	342, 352, 335, 336, 338, 96, 337, 64, 0, 84,
-	121, 100, 79, 115, 77, 0, 0, 0, 0, 340,
-	85, 0, 0, 104, 92, 0, 0, 0, 0, 333,
-	334, 0, 0, 0, 0, 0, 0, 0, 41, 0,
-	0, 271, 320, 319, 322, 323, 324, 325, 0, 0,
-	72, 321, 326, 327, 328, 0, 0, 0, 313, 0,
-	339, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 317, 318, 0, 0, 0,
+	0, 358, 0, 319, 0, 0, 315, 316, 321, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 143,
+	0, 0, 356, 0, 0, 103, 0, 0, 0, 75,
+	0, 108, 101, 0, 0, 102, 107, 90, 114, 84,
+	65, 112, 124, 74, 83, 106, 67, 118, 111, 94,
+	85, 86, 66, 0, 105, 78, 82, 77, 99, 115,
+	116, 76, 129, 70, 123, 69, 71, 122, 98, 113,
+	119, 95, 92, 68, 117, 93, 91, 87, 80, 0,
+	0, 0, 110, 120, 130, 0, 0, 125, 126, 127,
+	97, 72, 348, 357, 354, 355, 352, 353, 351, 350,
+	349, 359, 342, 343, 345, 0, 344, 64, 0, 88,
+	128, 104, 81, 121, 100, 0, 0, 0, 0, 309,
+	0, 0, 0, 79, 0, 308, 0, 0, 347, 89,
+	0, 0, 109, 96, 0, 0, 0, 0, 340, 341,
+	0, 0, 0, 0, 0, 0, 0, 41, 0, 0,
+	278, 327, 326, 329, 330, 331, 332, 0, 0, 73,
+	328, 333, 334, 335, 0, 0, 306, 320, 0, 346,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	310, 311, 0, 0, 0, 0, 351, 0, 312, 0,
-	0, 308, 309, 314, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 136, 0, 0, 349, 0, 0,
-	99, 0, 0, 0, 73, 0, 103, 97, 0, 0,
-	98, 102, 86, 108, 66, 112, 106, 90, 81, 82,
-	65, 0, 101, 76, 80, 75, 95, 109, 110, 74,
-	122, 69, 117, 68, 70, 116, 94, 107, 113, 91,
-	88, 67, 111, 89, 87, 83, 78, 0, 0, 0,
-	105, 114, 123, 0, 0, 118, 119, 120, 93, 71,
-	341, 350, 347, 348, 345, 346, 344, 343, 342, 352,
-	335, 336, 338, 0, 337, 64, 0, 84, 121, 100,
-	79, 115, 96, 0, 0, 0, 798, 0, 0, 0,
-	0, 77, 0, 0, 0, 0, 0, 85, 0, 0,
-	104, 92, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 62, 0,
-	800, 0, 0, 0, 0, 0, 0, 72, 0, 0,
-	0, 0, 461, 460, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 462,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
+	318, 0, 0, 0, 0, 358, 0, 319, 0, 0,
+	315, 316, 321, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 143, 0, 0, 356, 0, 0, 103,
+	0, 0, 0, 75, 0, 108, 101, 0, 0, 102,
+	107, 90, 114, 84, 65, 112, 124, 74, 83, 106,
+	67, 118, 111, 94, 85, 86, 66, 0, 105, 78,
+	82, 77, 99, 115, 116, 76, 129, 70, 123, 69,
+	71, 122, 98, 113, 119, 95, 92, 68, 117, 93,
+	91, 87, 80, 0, 0, 0, 110, 120, 130, 0,
+	0, 125, 126, 127, 97, 72, 348, 357, 354, 355,
+	352, 353, 351, 350, 349, 359, 342, 343, 345, 100,
+	344, 64, 0, 88, 128, 104, 81, 121, 79, 0,
+	0, 0, 0, 347, 89, 0, 0, 109, 96, 0,
+	0, 0, 0, 340, 341, 0, 0, 0, 0, 0,
+	0, 0, 41, 0, 0, 278, 327, 326, 329, 330,
+	331, 332, 0, 0, 73, 328, 333, 334, 335, 0,
+	0, 0, 320, 0, 346, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 317, 318, 0, 0, 0, 0,
+	358, 0, 319, 0, 0, 315, 316, 321, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 143, 0,
+	0, 356, 0, 0, 103, 0, 0, 0, 75, 0,
+	108, 101, 0, 1041, 102, 107, 90, 114, 84, 65,
+	112, 124, 74, 83, 106, 67, 118, 111, 94, 85,
+	86, 66, 0, 105, 78, 82, 77, 99, 115, 116,
+	76, 129, 70, 123, 69, 71, 122, 98, 113, 119,
+	95, 92, 68, 117, 93, 91, 87, 80, 0, 0,
+	0, 110, 120, 130, 0, 0, 125, 126, 127, 97,
+	72, 348, 357, 354, 355, 352, 353, 351, 350, 349,
+	359, 342, 343, 345, 100, 344, 64, 0, 88, 128,
+	104, 81, 121, 79, 0, 0, 0, 0, 347, 89,
+	0, 0, 109, 96, 0, 0, 0, 0, 340, 341,
+	0, 0, 0, 0, 0, 0, 0, 41, 0, 0,
+	278, 327, 326, 329, 330, 331, 332, 0, 0, 73,
+	328, 333, 334, 335, 0, 0, 0, 320, 0, 346,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
+	318, 0, 0, 0, 0, 358, 0, 319, 0, 0,
+	315, 316, 321, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 143, 0, 0, 356, 0, 0, 103,
+	0, 0, 0, 75, 0, 108, 101, 0, 0, 102,
+	107, 90, 114, 84, 65, 112, 124, 74, 83, 106,
+	67, 118, 111, 94, 85, 86, 66, 0, 105, 78,
+	82, 77, 99, 115, 116, 76, 129, 70, 123, 69,
+	71, 122, 98, 113, 119, 95, 92, 68, 117, 93,
+	91, 87, 80, 0, 0, 0, 110, 120, 130, 0,
+	0, 125, 126, 127, 97, 72, 348, 357, 354, 355,
+	352, 353, 351, 350, 349, 359, 342, 343, 345, 0,
+	344, 64, 0, 88, 128, 104, 81, 121, 100, 0,
+	0, 0, 815, 0, 0, 0, 0, 79, 0, 0,
+	0, 0, 0, 89, 0, 0, 109, 96, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 136, 0, 0, 0, 0, 0, 99, 0, 0,
-	0, 73, 0, 103, 97, 0, 0, 98, 102, 86,
-	108, 66, 112, 106, 90, 81, 82, 65, 0, 101,
-	76, 80, 75, 95, 109, 110, 74, 122, 69, 117,
-	68, 70, 116, 94, 107, 113, 91, 88, 67, 111,
-	89, 87, 83, 78, 0, 0, 0, 105, 114, 123,
-	96, 0, 118, 119, 120, 93, 71, 0, 0, 77,
-	0, 0, 0, 0, 0, 85, 0, 0, 104, 92,
-	0, 0, 64, 0, 84, 121, 100, 79, 115, 0,
-	0, 0, 0, 0, 0, 0, 62, 0, 0, 0,
-	0, 0, 0, 0, 0, 72, 0, 0, 0, 0,
-	58, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 62, 0, 817, 0, 0, 0,
+	0, 0, 0, 73, 0, 0, 0, 0, 469, 468,
+	0, 0, 0, 0, 0, 0, 0, 0, 476, 0,
+	479, 0, 0, 0, 0, 470, 493, 494, 495, 496,
+	497, 498, 499, 0, 477, 478, 475, 481, 480, 490,
+	491, 483, 484, 485, 486, 487, 488, 489, 482, 0,
+	0, 492, 0, 0, 0, 0, 0, 143, 0, 0,
+	0, 0, 0, 103, 0, 0, 0, 75, 0, 108,
+	101, 0, 0, 102, 107, 90, 114, 84, 65, 112,
+	124, 74, 83, 106, 67, 118, 111, 94, 85, 86,
+	66, 0, 105, 78, 82, 77, 99, 115, 116, 76,
+	129, 70, 123, 69, 71, 122, 98, 113, 119, 95,
+	92, 68, 117, 93, 91, 87, 80, 0, 0, 0,
+	110, 120, 130, 100, 0, 125, 126, 127, 97, 72,
+	0, 0, 79, 0, 0, 0, 0, 0, 89, 0,
+	0, 109, 96, 0, 0, 64, 0, 88, 128, 104,
+	81, 121, 0, 0, 0, 0, 0, 0, 0, 62,
+	0, 0, 0, 0, 0, 0, 0, 0, 73, 0,
+	0, 0, 0, 58, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 59, 0, 57,
-	0, 0, 0, 60, 0, 99, 0, 0, 0, 73,
-	0, 103, 97, 0, 0, 98, 102, 86, 108, 66,
-	112, 106, 90, 81, 82, 65, 0, 101, 76, 80,
-	75, 95, 109, 110, 74, 122, 69, 117, 68, 70,
-	116, 94, 107, 113, 91, 88, 67, 111, 89, 87,
-	83, 78, 0, 0, 0, 105, 114, 123, 0, 0,
-	118, 119, 120, 93, 71, 0, 0, 19, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 96, 0,
-	64, 0, 84, 121, 100, 79, 115, 77, 0, 0,
-	0, 0, 0, 85, 0, 0, 104, 92, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	59, 0, 57, 0, 0, 0, 60, 0, 103, 0,
+	0, 0, 75, 0, 108, 101, 0, 0, 102, 107,
+	90, 114, 84, 65, 112, 124, 74, 83, 106, 67,
+	118, 111, 94, 85, 86, 66, 0, 105, 78, 82,
+	77, 99, 115, 116, 76, 129, 70, 123, 69, 71,
+	122, 98, 113, 119, 95, 92, 68, 117, 93, 91,
+	87, 80, 0, 0, 0, 110, 120, 130, 0, 0,
+	125, 126, 127, 97, 72, 0, 0, 19, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 100, 0,
+	64, 0, 88, 128, 104, 81, 121, 79, 0, 0,
+	0, 0, 0, 89, 0, 0, 109, 96, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 41, 0, 0, 62, 0, 0, 0, 0, 0,
-	0, 0, 0, 72, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 73, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 143, 0, 0,
+	0, 0, 0, 103, 0, 0, 0, 75, 0, 108,
+	101, 0, 0, 102, 107, 90, 114, 84, 65, 112,
+	124, 74, 83, 106, 67, 118, 111, 94, 85, 86,
+	66, 0, 105, 78, 82, 77, 99, 115, 116, 76,
+	129, 70, 123, 69, 71, 122, 98, 113, 119, 95,
+	92, 68, 117, 93, 91, 87, 80, 0, 0, 0,
+	110, 120, 130, 0, 0, 125, 126, 127, 97, 72,
+	0, 0, 19, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 100, 0, 64, 0, 88, 128, 104,
+	81, 121, 79, 0, 0, 0, 0, 0, 89, 0,
+	0, 109, 96, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 41, 0, 0, 141,
+	0, 0, 0, 0, 0, 0, 0, 0, 73, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 136, 0, 0,
-	0, 0, 0, 99, 0, 0, 0, 73, 0, 103,
-	97, 0, 0, 98, 102, 86, 108, 66, 112, 106,
-	90, 81, 82, 65, 0, 101, 76, 80, 75, 95,
-	109, 110, 74, 122, 69, 117, 68, 70, 116, 94,
-	107, 113, 91, 88, 67, 111, 89, 87, 83, 78,
-	0, 0, 0, 105, 114, 123, 0, 0, 118, 119,
-	120, 93, 71, 0, 0, 19, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 96, 0, 64, 0,
-	84, 121, 100, 79, 115, 77, 0, 0, 0, 0,
-	0, 85, 0, 0, 104, 92, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 41,
-	0, 0, 134, 0, 0, 0, 0, 0, 0, 0,
-	0, 72, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 136, 0, 0, 0, 0,
-	0, 99, 0, 0, 0, 73, 0, 103, 97, 0,
-	0, 98, 102, 86, 108, 66, 112, 106, 90, 81,
-	82, 65, 0, 101, 76, 80, 75, 95, 109, 110,
-	74, 122, 69, 117, 68, 70, 116, 94, 107, 113,
-	91, 88, 67, 111, 89, 87, 83, 78, 0, 0,
-	0, 105, 114, 123, 96, 0, 118, 119, 120, 93,
-	71, 0, 0, 77, 0, 0, 0, 0, 0, 85,
-	0, 0, 104, 92, 0, 0, 64, 0, 84, 121,
-	100, 79, 115, 0, 0, 0, 0, 0, 0, 0,
-	62, 0, 0, 541, 0, 0, 542, 0, 0, 72,
+	0, 0, 143, 0, 0, 0, 0, 0, 103, 0,
+	0, 0, 75, 0, 108, 101, 0, 0, 102, 107,
+	90, 114, 84, 65, 112, 124, 74, 83, 106, 67,
+	118, 111, 94, 85, 86, 66, 0, 105, 78, 82,
+	77, 99, 115, 116, 76, 129, 70, 123, 69, 71,
+	122, 98, 113, 119, 95, 92, 68, 117, 93, 91,
+	87, 80, 0, 0, 0, 110, 120, 130, 100, 0,
+	125, 126, 127, 97, 72, 0, 0, 79, 0, 0,
+	0, 0, 0, 89, 0, 0, 109, 96, 0, 0,
+	64, 0, 88, 128, 104, 81, 121, 0, 0, 0,
+	0, 0, 0, 0, 62, 0, 0, 549, 0, 0,
+	550, 0, 0, 73, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 143, 0, 0,
+	0, 0, 0, 103, 0, 0, 0, 75, 0, 108,
+	101, 0, 0, 102, 107, 90, 114, 84, 65, 112,
+	124, 74, 83, 106, 67, 118, 111, 94, 85, 86,
+	66, 0, 105, 78, 82, 77, 99, 115, 116, 76,
+	129, 70, 123, 69, 71, 122, 98, 113, 119, 95,
+	92, 68, 117, 93, 91, 87, 80, 0, 0, 0,
+	110, 120, 130, 0, 0, 125, 126, 127, 97, 72,
+	0, 0, 0, 0, 0, 0, 0, 100, 0, 0,
+	0, 392, 0, 0, 0, 64, 79, 88, 128, 104,
+	81, 121, 89, 0, 0, 109, 96, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 136, 0, 0, 0, 0, 0, 99,
-	0, 0, 0, 73, 0, 103, 97, 0, 0, 98,
-	102, 86, 108, 66, 112, 106, 90, 81, 82, 65,
-	0, 101, 76, 80, 75, 95, 109, 110, 74, 122,
-	69, 117, 68, 70, 116, 94, 107, 113, 91, 88,
-	67, 111, 89, 87, 83, 78, 0, 0, 0, 105,
-	114, 123, 0, 0, 118, 119, 120, 93, 71, 0,
-	0, 0, 0, 0, 0, 0, 96, 0, 0, 0,
-	385, 0, 0, 0, 64, 77, 84, 121, 100, 79,
-	115, 85, 0, 0, 104, 92, 0, 0, 0, 0,
+	0, 0, 0, 141, 0, 394, 0, 0, 0, 0,
+	0, 0, 73, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 134, 0, 387, 0, 0, 0, 0, 0,
-	0, 72, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 143, 0, 0, 0,
+	0, 0, 103, 0, 0, 0, 75, 0, 108, 101,
+	0, 0, 102, 107, 90, 114, 84, 65, 112, 124,
+	74, 83, 106, 67, 118, 111, 94, 85, 86, 66,
+	0, 105, 78, 82, 77, 99, 115, 116, 76, 129,
+	70, 123, 69, 71, 122, 98, 113, 119, 95, 92,
+	68, 117, 93, 91, 87, 80, 0, 0, 0, 110,
+	120, 130, 100, 0, 125, 126, 127, 97, 72, 0,
+	0, 79, 0, 0, 0, 0, 0, 89, 0, 0,
+	109, 96, 0, 0, 64, 0, 88, 128, 104, 81,
+	121, 0, 0, 0, 0, 41, 0, 0, 141, 0,
+	0, 0, 0, 0, 0, 0, 0, 73, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 136, 0, 0, 0, 0,
-	0, 99, 0, 0, 0, 73, 0, 103, 97, 0,
-	0, 98, 102, 86, 108, 66, 112, 106, 90, 81,
-	82, 65, 0, 101, 76, 80, 75, 95, 109, 110,
-	74, 122, 69, 117, 68, 70, 116, 94, 107, 113,
-	91, 88, 67, 111, 89, 87, 83, 78, 0, 0,
-	0, 105, 114, 123, 96, 0, 118, 119, 120, 93,
-	71, 0, 0, 77, 0, 0, 0, 0, 0, 85,
-	0, 0, 104, 92, 0, 0, 64, 0, 84, 121,
-	100, 79, 115, 0, 0, 0, 0, 41, 0, 0,
-	134, 0, 0, 0, 0, 0, 0, 0, 0, 72,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 143, 0, 0, 0, 0, 0, 103, 0, 0,
+	0, 75, 0, 108, 101, 0, 0, 102, 107, 90,
+	114, 84, 65, 112, 124, 74, 83, 106, 67, 118,
+	111, 94, 85, 86, 66, 0, 105, 78, 82, 77,
+	99, 115, 116, 76, 129, 70, 123, 69, 71, 122,
+	98, 113, 119, 95, 92, 68, 117, 93, 91, 87,
+	80, 0, 0, 0, 110, 120, 130, 100, 0, 125,
+	126, 127, 97, 72, 0, 0, 79, 0, 0, 0,
+	0, 0, 89, 0, 0, 109, 96, 0, 0, 64,
+	0, 88, 128, 104, 81, 121, 0, 0, 0, 0,
+	0, 0, 0, 62, 0, 817, 0, 0, 0, 0,
+	0, 0, 73, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 136, 0, 0, 0, 0, 0, 99,
-	0, 0, 0, 73, 0, 103, 97, 0, 0, 98,
-	102, 86, 108, 66, 112, 106, 90, 81, 82, 65,
-	0, 101, 76, 80, 75, 95, 109, 110, 74, 122,
-	69, 117, 68, 70, 116, 94, 107, 113, 91, 88,
-	67, 111, 89, 87, 83, 78, 0, 0, 0, 105,
-	114, 123, 96, 0, 118, 119, 120, 93, 71, 0,
-	0, 77, 0, 0, 0, 0, 0, 85, 0, 0,
-	104, 92, 0, 0, 64, 0, 84, 121, 100, 79,
-	115, 0, 0, 0, 0, 0, 0, 0, 62, 0,
-	800, 0, 0, 0, 0, 0, 0, 72, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 143, 0, 0, 0,
+	0, 0, 103, 0, 0, 0, 75, 0, 108, 101,
+	0, 0, 102, 107, 90, 114, 84, 65, 112, 124,
+	74, 83, 106, 67, 118, 111, 94, 85, 86, 66,
+	0, 105, 78, 82, 77, 99, 115, 116, 76, 129,
+	70, 123, 69, 71, 122, 98, 113, 119, 95, 92,
+	68, 117, 93, 91, 87, 80, 0, 0, 0, 110,
+	120, 130, 100, 0, 125, 126, 127, 97, 72, 0,
+	0, 79, 0, 0, 0, 0, 0, 89, 0, 0,
+	109, 96, 0, 0, 64, 0, 88, 128, 104, 81,
+	121, 0, 0, 0, 0, 0, 0, 0, 141, 0,
+	394, 0, 0, 0, 0, 0, 0, 73, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 136, 0, 0, 0, 0, 0, 99, 0, 0,
-	0, 73, 0, 103, 97, 0, 0, 98, 102, 86,
-	108, 66, 112, 106, 90, 81, 82, 65, 0, 101,
-	76, 80, 75, 95, 109, 110, 74, 122, 69, 117,
-	68, 70, 116, 94, 107, 113, 91, 88, 67, 111,
-	89, 87, 83, 78, 0, 0, 0, 105, 114, 123,
-	96, 0, 118, 119, 120, 93, 71, 0, 0, 77,
-	0, 0, 0, 0, 0, 85, 0, 0, 104, 92,
-	0, 0, 64, 0, 84, 121, 100, 79, 115, 0,
-	0, 0, 0, 0, 0, 0, 134, 0, 387, 0,
-	0, 0, 0, 0, 0, 72, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 143, 0, 0, 0, 0, 0, 103, 0, 0,
+	0, 75, 0, 108, 101, 0, 0, 102, 107, 90,
+	114, 84, 65, 112, 124, 74, 83, 106, 67, 118,
+	111, 94, 85, 86, 66, 0, 105, 78, 82, 77,
+	99, 115, 116, 76, 129, 70, 123, 69, 71, 122,
+	98, 113, 119, 95, 92, 68, 117, 93, 91, 87,
+	80, 0, 0, 0, 110, 120, 130, 100, 0, 125,
+	126, 127, 97, 72, 0, 371, 79, 0, 0, 0,
+	0, 0, 89, 0, 0, 109, 96, 0, 0, 64,
+	0, 88, 128, 104, 81, 121, 0, 0, 0, 0,
+	0, 0, 0, 141, 0, 0, 0, 0, 0, 0,
+	0, 0, 73, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 136,
-	0, 0, 0, 0, 0, 99, 0, 0, 0, 73,
-	0, 103, 97, 0, 0, 98, 102, 86, 108, 66,
-	112, 106, 90, 81, 82, 65, 0, 101, 76, 80,
-	75, 95, 109, 110, 74, 122, 69, 117, 68, 70,
-	116, 94, 107, 113, 91, 88, 67, 111, 89, 87,
-	83, 78, 0, 0, 0, 105, 114, 123, 96, 0,
-	118, 119, 120, 93, 71, 0, 364, 77, 0, 0,
-	0, 0, 0, 85, 0, 0, 104, 92, 0, 0,
-	64, 0, 84, 121, 100, 79, 115, 0, 0, 0,
-	0, 0, 0, 0, 134, 0, 0, 0, 0, 0,
-	0, 0, 0, 72, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 143, 0, 0, 0,
+	0, 0, 103, 0, 0, 0, 75, 0, 108, 101,
+	0, 0, 102, 107, 90, 114, 84, 65, 112, 124,
+	74, 83, 106, 67, 118, 111, 94, 85, 86, 66,
+	0, 105, 78, 82, 77, 99, 115, 116, 76, 129,
+	70, 123, 69, 71, 122, 98, 113, 119, 95, 92,
+	68, 117, 93, 91, 87, 80, 266, 0, 0, 110,
+	120, 130, 0, 100, 125, 126, 127, 97, 72, 0,
+	0, 0, 79, 0, 0, 0, 0, 0, 89, 0,
+	0, 109, 96, 0, 64, 0, 88, 128, 104, 81,
+	121, 0, 0, 0, 0, 0, 0, 0, 0, 141,
+	0, 0, 0, 0, 0, 0, 0, 0, 73, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 136, 0, 0,
-	0, 0, 0, 99, 0, 0, 0, 73, 0, 103,
-	97, 0, 0, 98, 102, 86, 108, 66, 112, 106,
-	90, 81, 82, 65, 0, 101, 76, 80, 75, 95,
-	109, 110, 74, 122, 69, 117, 68, 70, 116, 94,
-	107, 113, 91, 88, 67, 111, 89, 87, 83, 78,
-	259, 0, 0, 105, 114, 123, 0, 96, 118, 119,
-	120, 93, 71, 0, 0, 0, 77, 0, 0, 0,
-	0, 0, 85, 0, 0, 104, 92, 0, 64, 0,
-	84, 121, 100, 79, 115, 0, 0, 0, 0, 0,
-	0, 0, 0, 134, 0, 0, 0, 0, 0, 0,
-	0, 0, 72, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 136, 0, 0, 0,
-	0, 0, 99, 0, 0, 0, 73, 0, 103, 97,
-	0, 0, 98, 102, 86, 108, 66, 112, 106, 90,
-	81, 82, 65, 0, 101, 76, 80, 75, 95, 109,
-	110, 74, 122, 69, 117, 68, 70, 116, 94, 107,
-	113, 91, 88, 67, 111, 89, 87, 83, 78, 0,
-	0, 0, 105, 114, 123, 96, 0, 118, 119, 120,
-	93, 71, 0, 0, 77, 0, 0, 0, 0, 0,
-	85, 0, 0, 104, 92, 0, 0, 64, 0, 84,
-	121, 100, 79, 115, 0, 0, 0, 0, 0, 0,
-	0, 134, 0, 0, 0, 0, 0, 0, 0, 0,
-	72, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 143, 0, 0, 0, 0, 0, 103, 0,
+	0, 0, 75, 0, 108, 101, 0, 0, 102, 107,
+	90, 114, 84, 65, 112, 124, 74, 83, 106, 67,
+	118, 111, 94, 85, 86, 66, 0, 105, 78, 82,
+	77, 99, 115, 116, 76, 129, 70, 123, 69, 71,
+	122, 98, 113, 119, 95, 92, 68, 117, 93, 91,
+	87, 80, 0, 0, 0, 110, 120, 130, 100, 0,
+	125, 126, 127, 97, 72, 0, 0, 79, 0, 0,
+	0, 0, 0, 89, 0, 0, 109, 96, 0, 0,
+	64, 0, 88, 128, 104, 81, 121, 0, 0, 0,
+	0, 0, 0, 0, 141, 0, 0, 0, 0, 0,
+	0, 0, 0, 73, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 131, 0, 136, 0, 0, 0, 0, 0,
-	99, 0, 0, 0, 73, 0, 103, 97, 0, 0,
-	98, 102, 86, 108, 66, 112, 106, 90, 81, 82,
-	65, 0, 101, 76, 80, 75, 95, 109, 110, 74,
-	122, 69, 117, 68, 70, 116, 94, 107, 113, 91,
-	88, 67, 111, 89, 87, 83, 78, 0, 0, 0,
-	105, 114, 123, 96, 0, 118, 119, 120, 93, 71,
-	0, 0, 77, 0, 0, 0, 0, 0, 85, 0,
-	0, 104, 92, 0, 0, 64, 0, 84, 121, 100,
-	79, 115, 0, 0, 0, 0, 0, 0, 0, 62,
-	0, 0, 0, 0, 0, 0, 0, 0, 72, 0,
+	0, 0, 0, 0, 0, 138, 0, 143, 0, 0,
+	0, 0, 0, 103, 0, 0, 0, 75, 0, 108,
+	101, 0, 0, 102, 107, 90, 114, 84, 65, 112,
+	124, 74, 83, 106, 67, 118, 111, 94, 85, 86,
+	66, 0, 105, 78, 82, 77, 99, 115, 116, 76,
+	129, 70, 123, 69, 71, 122, 98, 113, 119, 95,
+	92, 68, 117, 93, 91, 87, 80, 0, 0, 0,
+	110, 120, 130, 100, 0, 125, 126, 127, 97, 72,
+	0, 0, 79, 0, 0, 0, 0, 0, 89, 0,
+	0, 109, 96, 0, 0, 64, 0, 88, 128, 104,
+	81, 121, 0, 0, 0, 0, 0, 0, 0, 62,
+	0, 0, 0, 0, 0, 0, 0, 0, 73, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 136, 0, 0, 0, 0, 0, 99, 0,
-	0, 0, 73, 0, 103, 97, 0, 0, 98, 102,
-	86, 108, 66, 112, 106, 90, 81, 82, 65, 0,
-	101, 76, 80, 75, 95, 109, 110, 74, 122, 69,
-	117, 68, 70, 116, 94, 107, 113, 91, 88, 67,
-	111, 89, 87, 83, 78, 0, 0, 0, 105, 114,
-	123, 96, 0, 118, 119, 120, 93, 71, 0, 0,
-	77, 0, 0, 0, 0, 0, 85, 0, 0, 104,
-	92, 0, 0, 64, 0, 84, 121, 100, 79, 115,
-	0, 0, 0, 0, 0, 0, 0, 271, 0, 0,
-	0, 0, 0, 0, 0, 0, 72, 0, 0, 0,
+	0, 0, 143, 0, 0, 0, 0, 0, 103, 0,
+	0, 0, 75, 0, 108, 101, 0, 0, 102, 107,
+	90, 114, 84, 65, 112, 124, 74, 83, 106, 67,
+	118, 111, 94, 85, 86, 66, 0, 105, 78, 82,
+	77, 99, 115, 116, 76, 129, 70, 123, 69, 71,
+	122, 98, 113, 119, 95, 92, 68, 117, 93, 91,
+	87, 80, 0, 0, 0, 110, 120, 130, 100, 0,
+	125, 126, 127, 97, 72, 0, 0, 79, 0, 0,
+	0, 0, 0, 89, 0, 0, 109, 96, 0, 0,
+	64, 0, 88, 128, 104, 81, 121, 0, 0, 0,
+	0, 0, 0, 0, 141, 0, 0, 0, 0, 0,
+	0, 0, 0, 73, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 143, 0, 0,
+	0, 0, 0, 103, 0, 0, 0, 75, 0, 108,
+	101, 0, 0, 102, 107, 90, 114, 84, 65, 112,
+	124, 74, 83, 106, 67, 118, 111, 94, 85, 86,
+	66, 0, 105, 78, 82, 77, 99, 115, 116, 76,
+	129, 70, 123, 69, 71, 122, 98, 113, 119, 95,
+	92, 68, 117, 93, 91, 87, 80, 0, 0, 0,
+	110, 120, 130, 100, 0, 125, 126, 127, 97, 72,
+	0, 0, 79, 0, 0, 0, 0, 0, 89, 0,
+	0, 109, 96, 0, 0, 64, 0, 88, 128, 104,
+	81, 121, 0, 0, 0, 0, 0, 0, 0, 278,
+	0, 0, 0, 0, 0, 0, 0, 0, 73, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	136, 0, 0, 0, 0, 0, 99, 0, 0, 0,
-	73, 0, 103, 97, 0, 0, 98, 102, 86, 108,
-	66, 112, 106, 90, 81, 82, 65, 0, 101, 76,
-	80, 75, 95, 109, 110, 74, 122, 69, 117, 68,
-	70, 116, 94, 107, 113, 91, 88, 67, 111, 89,
-	87, 83, 78, 0, 0, 0, 105, 114, 123, 96,
-	0, 118, 119, 120, 93, 71, 0, 0, 77, 0,
-	0, 0, 0, 0, 85, 0, 0, 104, 92, 0,
-	0, 64, 0, 84, 121, 100, 79, 115, 0, 0,
-	0, 0, 0, 0, 0, 134, 0, 0, 0, 0,
-	0, 0, 0, 0, 72, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 136, 0,
-	0, 0, 0, 0, 99, 0, 0, 0, 73, 0,
-	103, 97, 0, 0, 98, 102, 86, 108, 66, 112,
-	106, 90, 81, 82, 65, 0, 101, 76, 80, 75,
-	95, 109, 110, 74, 122, 69, 117, 68, 70, 116,
-	94, 107, 113, 91, 88, 67, 111, 89, 87, 83,
-	78, 0, 0, 0, 105, 114, 123, 96, 0, 118,
-	119, 120, 93, 71, 0, 0, 77, 0, 0, 0,
-	0, 0, 85, 0, 0, 104, 92, 0, 0, 64,
-	0, 84, 121, 100, 79, 115, 0, 0, 0, 0,
-	0, 0, 0, 271, 0, 0, 0, 0, 0, 0,
-	0, 0, 72, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 143, 0, 0, 0, 0, 0, 103, 0,
+	0, 0, 75, 0, 108, 101, 0, 0, 102, 107,
+	90, 114, 84, 65, 112, 124, 74, 83, 106, 67,
+	118, 111, 94, 85, 86, 66, 0, 105, 78, 82,
+	77, 99, 115, 116, 76, 129, 70, 123, 69, 71,
+	122, 98, 113, 119, 95, 92, 68, 117, 93, 91,
+	87, 80, 0, 0, 0, 110, 120, 130, 100, 0,
+	125, 126, 127, 97, 72, 0, 0, 79, 0, 0,
+	0, 0, 0, 89, 0, 0, 109, 96, 0, 0,
+	64, 0, 88, 128, 104, 81, 121, 0, 0, 0,
+	0, 0, 0, 0, 278, 0, 0, 0, 0, 0,
+	0, 0, 0, 73, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 136, 0, 0, 0,
-	0, 0, 99, 0, 0, 0, 73, 0, 103, 97,
-	0, 0, 98, 102, 86, 108, 66, 112, 106, 90,
-	81, 82, 65, 0, 101, 76, 80, 75, 95, 109,
-	110, 74, 122, 69, 117, 68, 265, 116, 94, 107,
-	113, 91, 88, 67, 111, 89, 87, 83, 78, 0,
-	0, 0, 105, 114, 123, 0, 0, 118, 119, 120,
-	266, 264, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 64, 0, 84,
-	121, 100, 79, 115,
+	0, 0, 0, 0, 0, 0, 0, 143, 0, 0,
+	0, 0, 0, 103, 0, 0, 0, 75, 0, 108,
+	101, 0, 0, 102, 107, 90, 114, 84, 65, 112,
+	124, 74, 83, 106, 67, 118, 111, 94, 85, 86,
+	66, 0, 105, 78, 82, 77, 99, 115, 116, 76,
+	129, 70, 123, 69, 272, 122, 98, 113, 119, 95,
+	92, 68, 117, 93, 91, 87, 80, 0, 0, 0,
+	110, 120, 130, 0, 0, 125, 126, 127, 273, 271,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 64, 0, 88, 128, 104,
+	81, 121,
 }
 var yyPact = [...]int{
 
-	1031, -1000, -144, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 615, 633, -1000,
-	-1000, -1000, -1000, -1000, 465, 5123, 22, 44, 31, 6758,
-	43, 3485, 7232, -1000, -1000, -1000, -1000, -1000, 459, -1000,
-	-1000, -1000, -1000, -1000, 607, 613, 468, 601, 524, -1000,
-	16, 5967, 6600, 7390, -1000, 320, 40, 7232, -108, 14,
+	1018, -1000, -164, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 734, 756, -1000,
+	-1000, -1000, -1000, -1000, 548, 5256, 2, 53, 56, 6961,
+	33, 3555, 7291, -1000, -1000, -1000, -1000, -1000, 547, -1000,
+	-1000, -1000, -1000, -1000, 696, 715, 551, 690, 625, -1000,
+	-1, 6135, 6796, 7621, -1000, 320, 28, 7291, -132, -3,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 42, 7232, -1000, 7232, 12, 314,
-	12, 7232, -1000, 69, -1000, -1000, -1000, 7232, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 32, 7291, -1000, 7291, -4, 308, -4, 7291, -1000,
+	99, -1000, -1000, -1000, 7291, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -1321,153 +1363,158 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 245, 574, 4422, 4422,
-	615, -1000, 459, -1000, -1000, -1000, 570, -1000, -1000, 181,
-	6441, 405, 486, -1000, -1000, -1000, 598, 5479, 5809, 7232,
-	114, -1000, 2689, 345, -1000, 562, -1000, -1000, 119, -1000,
-	66, -1000, -1000, 362, -1000, 1161, 312, 2291, 23, 7232,
-	145, 7232, 2291, 24, 7232, 592, 487, 7232, -1000, 3286,
-	-1000, -1000, -1000, -1000, -1000, 631, 97, 185, -1000, 4422,
-	1093, 425, 425, -1000, -1000, 54, -1000, -1000, 4778, 4778,
-	4778, 4778, 4778, 4778, 4778, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 425,
-	65, -1000, 4235, 425, 425, 425, 425, 425, 425, 4422,
-	425, 425, 425, 425, 425, 425, 425, 425, 425, 425,
-	425, 425, 425, 417, -1000, 180, 607, 245, 524, 5637,
-	477, -1000, -1000, 392, 7232, -1000, 7074, 5967, 5967, 5967,
-	5967, -1000, 508, 506, -1000, 500, 494, 516, 7232, -1000,
-	357, 245, 5479, 80, -1000, 6283, -1000, -1000, 624, 5967,
-	7232, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 7074, -1000,
-	4422, 3087, 1893, 96, 199, -83, -1000, -1000, 441, -1000,
-	441, 441, 441, 441, -63, -63, -63, -63, -1000, -1000,
-	-1000, -1000, -1000, 464, -1000, 441, 441, 441, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 461, 461, 461, 443,
-	443, 4, -1000, -1000, -1000, 7232, -1000, 585, 67, -1000,
-	7232, -1000, -1000, 7232, 2291, -1000, -1000, -1000, -1000, 530,
-	4422, 4422, 224, 4422, 4422, 106, 4778, 191, 159, 4778,
-	4778, 4778, 4778, 4778, 4778, 4778, 4778, 4778, 4778, 4778,
-	4778, 4778, 4778, 4778, 207, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 287, -1000, 459, 554, 554, 78, 78,
-	78, 78, 78, 78, 1419, 3672, 3087, 349, 126, 4235,
-	3859, 3859, 4422, 4422, 3859, 602, 135, 126, 6916, -1000,
-	245, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 3859, 3859,
-	3859, 3859, 4422, -1000, -1000, -1000, 574, -1000, 602, 614,
-	-1000, 539, 538, 3859, -1000, 485, 7074, 425, -1000, 5301,
-	-1000, 399, 486, 474, 634, -1000, -1000, -1000, -1000, 504,
-	-1000, 503, -1000, -1000, -1000, -1000, -1000, 245, -1000, 38,
-	36, 28, -1000, 615, 4422, 371, -1000, -1000, -1000, 126,
-	-1000, 63, -1000, 415, 1694, -1000, -1000, -1000, -1000, -1000,
-	-1000, 447, 575, 123, 261, -1000, -1000, 565, -1000, 169,
-	-85, -1000, -1000, 225, -63, -63, -1000, -1000, 71, 546,
-	71, 71, 71, 240, -1000, -1000, -1000, -1000, 221, -1000,
-	-1000, -1000, 217, -1000, 473, 6916, 2291, -1000, -1000, 105,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -15, -1000, 2291, -1000, 520, 106, 137, -1000,
-	-1000, 244, -1000, -1000, 126, 126, 1371, -1000, -1000, -1000,
-	-1000, 191, 4778, 4778, 4778, 630, 1371, 1310, 264, 1181,
-	78, 130, 130, 89, 89, 89, 89, 89, 484, 484,
-	-1000, -1000, -1000, 245, -1000, -1000, -1000, 245, 3859, 412,
-	-1000, -1000, 4965, 62, 425, 4422, -1000, 276, 276, 68,
-	198, 276, 3859, 211, -1000, 4422, 245, -1000, 276, 245,
-	276, 276, -1000, -1000, 7232, -1000, -1000, -1000, -1000, 395,
-	-1000, 571, 369, 364, -1000, -1000, 4046, 245, 341, 61,
-	615, 4422, 4422, -1000, -1000, -1000, 425, 425, 425, 607,
-	126, -1000, 2888, 1893, -1000, 1893, 6916, -1000, 252, -1000,
-	-1000, 470, 57, -1000, -1000, -1000, 325, 71, 71, -1000,
-	227, 110, -1000, -1000, -1000, 328, -1000, 407, 280, 7232,
-	-1000, -1000, -1000, 7232, -1000, -1000, -1000, -1000, -1000, 6916,
-	-1000, -1000, -1000, -1000, -1000, -1000, 630, 1371, 1295, -1000,
-	4778, 4778, -1000, -1000, 276, 3859, -1000, -1000, 6125, -1000,
-	-1000, 2490, 3859, 126, -1000, -1000, 34, 207, 34, -117,
-	380, 121, -1000, 4422, 187, -1000, -1000, -1000, -1000, -1000,
-	-1000, 624, 5967, 573, -1000, 425, -1000, -1000, 410, 6916,
-	6916, 607, 126, 126, 6916, 6916, 6916, -1000, -1000, 1694,
-	-1000, 274, -1000, 441, -1000, -79, 629, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 236,
-	200, -1000, 194, 2291, -1000, -1000, 581, -1000, 4778, 1371,
-	1371, -1000, -1000, -1000, -1000, 60, 245, 245, 441, 441,
-	-1000, 441, 443, -1000, 441, -32, 441, -47, 245, 245,
-	425, -113, -1000, 126, 4422, 621, 406, 628, -1000, 425,
-	-1000, 459, 58, -1000, -1000, 272, -1000, 272, 272, -1000,
-	6916, -1000, 95, -1000, -94, -1000, 323, 277, -1000, 425,
-	1371, 2092, -1000, -1000, -1000, 39, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 4778, 245, 232, 126, 617, 611,
-	7074, 364, 245, 6916, -1000, 6916, -1000, -1000, -1000, 172,
-	572, -1000, 569, -1000, -1000, -1000, -16, -1000, -1000, -1000,
-	9, -1000, -1000, -1000, 4422, 4422, 345, -1000, -1000, -1000,
-	-1000, 230, -1000, -1000, 260, -1000, 6916, 245, 33, -125,
-	126, 338, -1000, -1000, -16, 536, -1000, 515, -121, -138,
-	-1000, -19, -1000, 513, -1000, -25, -123, 425, -134, 4600,
-	-140, 1155, 245, -1000, -1000, -1000,
+	-1000, -1000, -1000, 330, 672, 4527, 4527, 734, -1000, 547,
+	-1000, -1000, -1000, 635, -1000, -1000, 172, 6630, 419, 761,
+	-1000, -1000, -1000, 683, 5626, 5970, 7291, 130, -1000, 2731,
+	427, -1000, 641, -1000, -1000, 137, -1000, 95, -1000, -1000,
+	473, -1000, 1277, 305, 2319, 12, 7291, 154, 7291, 2319,
+	7, 7291, 679, 560, 7291, -1000, 3349, -1000, -1000, -1000,
+	-1000, -1000, 752, 124, 614, -1000, 4527, 5099, 510, 510,
+	-1000, -1000, 92, -1000, -1000, 4897, 4897, 4897, 4897, 4897,
+	4897, 4897, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 510, 91, -1000, 4333,
+	510, 510, 510, 510, 510, 510, 4527, 510, 510, 510,
+	510, 510, 510, 510, 510, 510, 510, 510, 510, 510,
+	504, -1000, 196, 696, 330, 625, 5791, 570, -1000, -1000,
+	546, 7291, -1000, 7456, 6135, 6135, 6135, 6135, -1000, 596,
+	595, -1000, 606, 597, 617, 7291, -1000, 459, 330, 5626,
+	89, -1000, 6465, -1000, -1000, 742, 6135, 7291, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 7456, -1000, 4527, 3143, 1907,
+	69, -35, -111, -1000, -1000, 515, -1000, 515, 515, 515,
+	515, -90, -90, -90, -90, -1000, -1000, -1000, -1000, -1000,
+	545, 544, -1000, 515, 515, 515, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 531, 531, 531, 516, 516, -11,
+	-1000, -1000, -1000, 7291, -1000, 678, 116, -1000, 7291, -1000,
+	-1000, 7291, 2319, -1000, -1000, -1000, -1000, 619, 4527, 4527,
+	237, 4527, 4527, 132, 4897, 236, 191, 4897, 4897, 4897,
+	4897, 4897, 4897, 4897, 4897, 4897, 4897, 4897, 4897, 4897,
+	4897, 4897, 252, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 289, -1000, 547, 1039, 1039, 106, 106, 106, 106,
+	106, 106, 1443, 3749, 3143, 421, 188, 4333, 3943, 3943,
+	4527, 4527, 3943, 687, 102, 188, 7126, -1000, 330, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 3943, 3943, 3943, 3943,
+	4527, -1000, -1000, -1000, 672, -1000, 687, 739, -1000, 636,
+	633, 3943, -1000, 559, 7456, 510, -1000, 5441, -1000, 494,
+	761, 557, 477, -1000, -1000, -1000, -1000, 594, -1000, 588,
+	-1000, -1000, -1000, -1000, -1000, 330, -1000, 23, 19, 14,
+	-1000, 734, 4527, 483, -1000, -1000, -1000, 188, -1000, 79,
+	-1000, 498, 1677, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	519, -53, 668, 115, 287, 282, -1000, -1000, 190, 682,
+	-47, -113, -1000, -1000, 235, -90, -90, -1000, -1000, 93,
+	640, 93, 93, 93, 270, 270, -1000, -1000, -1000, -1000,
+	218, -1000, -1000, -1000, 205, -1000, 556, 7126, 2319, -1000,
+	-1000, 98, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -23, -1000, 2319, -1000, 615, 132,
+	189, -1000, -1000, 247, -1000, -1000, 188, 188, 645, -1000,
+	-1000, -1000, -1000, 236, 4897, 4897, 4897, 148, 645, 626,
+	680, 808, 106, 590, 590, 88, 88, 88, 88, 88,
+	307, 307, -1000, -1000, -1000, 330, -1000, -1000, -1000, 330,
+	3943, 490, -1000, -1000, 5091, 76, 510, 4527, -1000, 336,
+	336, 81, 295, 336, 3943, 156, -1000, 4527, 330, -1000,
+	336, 330, 336, 336, -1000, -1000, 7291, -1000, -1000, -1000,
+	-1000, 491, -1000, 670, 463, 478, -1000, -1000, 4137, 330,
+	409, 73, 734, 4527, 4527, -1000, -1000, -1000, 510, 510,
+	510, 696, 188, -1000, 2937, 1907, -1000, 1907, 7126, 666,
+	-1000, 278, -1000, -1000, -1000, 644, -1000, 160, 510, -1000,
+	-1000, -1000, 392, 93, 93, -1000, 274, 157, -1000, -1000,
+	-1000, 356, -1000, 343, 489, 340, 7291, -1000, -1000, -1000,
+	7291, -1000, -1000, -1000, -1000, -1000, 7126, -1000, -1000, -1000,
+	-1000, -1000, -1000, 148, 645, 523, -1000, 4897, 4897, -1000,
+	-1000, 336, 3943, -1000, -1000, 6300, -1000, -1000, 2525, 3943,
+	188, -1000, -1000, 38, 252, 38, -140, 484, 145, -1000,
+	4527, 214, -1000, -1000, -1000, -1000, -1000, -1000, 742, 6135,
+	653, -1000, 510, -1000, -1000, 593, 7126, 7126, 696, 188,
+	188, 7126, 7126, 7126, -1000, -1000, 1677, -1000, 319, -1000,
+	515, 517, -1000, 524, 36, -1000, 4527, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 259, -1000, 197, -1000, 179, 2319,
+	-1000, -1000, 674, -1000, 4897, 645, 645, -1000, -1000, -1000,
+	-1000, 71, 330, 330, 515, 515, -1000, 515, 516, -1000,
+	515, -62, 515, -66, 330, 330, 510, -137, -1000, 188,
+	4527, 740, 485, 746, -1000, 510, -1000, 547, 67, -1000,
+	-1000, 317, -1000, 317, 317, -1000, 7126, -1000, 7126, -107,
+	743, -1000, -1000, -1000, -1000, -1000, 216, -1000, 337, 333,
+	-1000, 510, 645, 2113, -1000, -1000, -1000, 30, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 4897, 330, 254, 188,
+	737, 714, 7456, 478, 330, 7126, -1000, 7126, -1000, -1000,
+	-1000, 314, -1000, 84, -1000, -122, -41, -1000, -1000, -24,
+	-1000, -1000, -1000, 64, -1000, -1000, -1000, 4527, 4527, 427,
+	-1000, -1000, -1000, -55, 7126, 170, 648, -1000, 647, -1000,
+	-1000, -1000, -1000, 293, -1000, 7126, 330, 17, -152, 188,
+	415, 7291, -1000, -1000, 239, -1000, -1000, -1000, -24, 629,
+	-1000, 608, -144, -155, 513, -1000, -1000, -27, -1000, 601,
+	-1000, 7126, -29, -150, 286, 510, -153, -1000, 4712, -161,
+	258, 330, -1000, -1000, -1000,
 }
 var yyPgo = [...]int{
 
-	0, 848, 14, 537, 845, 844, 842, 840, 838, 833,
-	828, 824, 820, 819, 818, 817, 816, 809, 808, 807,
-	63, 806, 805, 804, 44, 801, 58, 791, 789, 27,
-	170, 29, 26, 563, 788, 13, 75, 61, 787, 785,
-	784, 57, 783, 978, 781, 779, 772, 21, 19, 770,
-	769, 767, 761, 43, 6, 758, 751, 749, 748, 747,
-	746, 38, 3, 8, 24, 11, 745, 130, 5, 744,
-	30, 743, 742, 741, 740, 51, 735, 40, 730, 34,
-	31, 717, 33, 7, 716, 45, 715, 461, 713, 118,
-	712, 711, 710, 708, 707, 706, 23, 16, 186, 9,
-	28, 704, 703, 835, 32, 54, 698, 697, 35, 17,
-	22, 12, 696, 694, 692, 690, 689, 688, 687, 25,
-	684, 678, 672, 10, 41, 668, 667, 56, 18, 666,
-	665, 663, 660, 50, 659, 37, 658, 657, 656, 36,
-	20, 655, 4, 651, 650, 2, 649, 648, 647, 0,
-	66, 646, 640, 159,
+	0, 1004, 84, 626, 998, 997, 994, 991, 988, 987,
+	986, 985, 984, 983, 982, 978, 975, 974, 972, 967,
+	81, 965, 964, 963, 40, 961, 72, 959, 957, 25,
+	31, 18, 20, 649, 955, 43, 127, 89, 953, 952,
+	951, 61, 950, 41, 949, 946, 945, 22, 16, 943,
+	942, 941, 940, 38, 1, 938, 937, 935, 933, 931,
+	928, 34, 4, 8, 23, 13, 926, 131, 6, 925,
+	29, 923, 922, 921, 919, 21, 918, 39, 915, 10,
+	37, 913, 28, 7, 912, 51, 911, 572, 909, 114,
+	893, 892, 888, 887, 885, 882, 44, 0, 421, 59,
+	19, 881, 879, 947, 46, 49, 877, 870, 42, 14,
+	17, 15, 867, 862, 858, 851, 845, 844, 839, 837,
+	836, 112, 826, 822, 821, 12, 24, 820, 819, 50,
+	9, 818, 813, 812, 811, 35, 45, 808, 796, 794,
+	2, 36, 793, 792, 790, 27, 11, 789, 5, 786,
+	785, 3, 784, 780, 767, 74, 150, 766, 765, 234,
 }
 var yyR1 = [...]int{
 
-	0, 147, 148, 148, 1, 1, 1, 1, 1, 1,
+	0, 153, 154, 154, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 2, 2,
 	2, 3, 4, 4, 5, 5, 6, 6, 23, 23,
-	7, 8, 8, 151, 151, 39, 39, 9, 9, 84,
-	84, 84, 102, 102, 10, 10, 10, 10, 15, 136,
-	137, 137, 137, 133, 113, 113, 113, 116, 116, 114,
-	114, 114, 114, 114, 114, 114, 115, 115, 115, 115,
-	115, 117, 117, 117, 117, 117, 118, 118, 118, 118,
+	7, 8, 8, 157, 157, 39, 39, 9, 9, 84,
+	84, 84, 102, 102, 10, 10, 10, 10, 15, 142,
+	143, 143, 143, 143, 136, 113, 113, 113, 116, 116,
+	114, 114, 114, 114, 114, 114, 114, 115, 115, 115,
+	115, 115, 117, 117, 117, 117, 117, 118, 118, 118,
 	118, 118, 118, 118, 118, 118, 118, 118, 118, 118,
-	132, 132, 119, 119, 127, 127, 128, 128, 128, 125,
-	125, 126, 126, 129, 129, 129, 120, 120, 120, 120,
-	120, 120, 122, 122, 130, 130, 123, 123, 123, 124,
-	124, 131, 131, 131, 131, 131, 121, 121, 134, 141,
-	141, 141, 141, 135, 135, 143, 143, 142, 138, 138,
-	138, 139, 139, 139, 140, 140, 140, 11, 11, 11,
-	11, 11, 146, 144, 144, 145, 145, 12, 13, 13,
-	13, 14, 14, 16, 112, 112, 112, 17, 18, 18,
-	19, 19, 19, 19, 19, 152, 20, 21, 21, 22,
-	22, 22, 26, 26, 26, 24, 24, 25, 25, 31,
-	31, 30, 30, 32, 32, 32, 32, 101, 101, 101,
-	100, 100, 34, 34, 35, 35, 36, 36, 37, 37,
-	37, 45, 38, 38, 38, 38, 107, 107, 106, 106,
-	106, 105, 105, 40, 40, 40, 40, 41, 41, 41,
-	41, 42, 42, 44, 44, 43, 43, 46, 46, 46,
-	46, 47, 47, 48, 48, 33, 33, 33, 33, 33,
-	33, 33, 88, 88, 50, 50, 49, 49, 49, 49,
-	49, 49, 49, 49, 49, 49, 60, 60, 60, 60,
-	60, 60, 51, 51, 51, 51, 51, 51, 51, 29,
-	29, 61, 61, 61, 67, 62, 62, 54, 54, 54,
+	118, 118, 135, 135, 121, 121, 129, 129, 130, 130,
+	130, 127, 127, 128, 128, 119, 119, 120, 120, 133,
+	133, 133, 131, 131, 131, 122, 122, 122, 122, 122,
+	122, 124, 124, 132, 132, 125, 125, 125, 126, 126,
+	134, 134, 134, 134, 134, 123, 123, 137, 138, 139,
+	139, 140, 140, 147, 147, 147, 147, 141, 141, 149,
+	149, 148, 144, 144, 144, 145, 145, 145, 146, 146,
+	146, 11, 11, 11, 11, 11, 152, 150, 150, 151,
+	151, 12, 13, 13, 13, 14, 14, 16, 112, 112,
+	112, 17, 18, 18, 19, 19, 19, 19, 19, 158,
+	20, 21, 21, 22, 22, 22, 26, 26, 26, 24,
+	24, 25, 25, 31, 31, 30, 30, 32, 32, 32,
+	32, 101, 101, 101, 100, 100, 34, 34, 35, 35,
+	36, 36, 37, 37, 37, 45, 38, 38, 38, 38,
+	107, 107, 106, 106, 106, 105, 105, 40, 40, 40,
+	40, 41, 41, 41, 41, 42, 42, 44, 44, 43,
+	43, 46, 46, 46, 46, 47, 47, 48, 48, 33,
+	33, 33, 33, 33, 33, 33, 88, 88, 50, 50,
+	49, 49, 49, 49, 49, 49, 49, 49, 49, 49,
+	60, 60, 60, 60, 60, 60, 51, 51, 51, 51,
+	51, 51, 51, 29, 29, 61, 61, 61, 67, 62,
+	62, 54, 54, 54, 54, 54, 54, 54, 54, 54,
 	54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
 	54, 54, 54, 54, 54, 54, 54, 54, 54, 54,
-	54, 54, 54, 54, 54, 54, 54, 54, 58, 58,
-	58, 56, 56, 56, 56, 56, 56, 56, 56, 56,
-	57, 57, 57, 57, 57, 57, 57, 57, 153, 153,
-	59, 59, 59, 59, 27, 27, 27, 27, 27, 110,
-	110, 111, 111, 111, 111, 111, 111, 111, 111, 111,
-	111, 111, 111, 111, 71, 71, 28, 28, 69, 69,
-	70, 72, 72, 68, 68, 68, 53, 53, 53, 53,
-	53, 53, 53, 53, 55, 55, 55, 73, 73, 74,
-	74, 75, 75, 76, 76, 77, 78, 78, 78, 79,
-	79, 79, 79, 80, 80, 80, 52, 52, 52, 52,
-	52, 52, 81, 81, 81, 81, 82, 82, 63, 63,
-	65, 65, 64, 66, 83, 83, 85, 86, 86, 89,
-	89, 90, 90, 87, 87, 91, 91, 91, 91, 91,
-	91, 91, 91, 91, 91, 91, 92, 92, 92, 93,
-	93, 94, 94, 94, 95, 95, 98, 98, 99, 99,
-	103, 103, 104, 104, 96, 96, 96, 96, 96, 96,
+	54, 54, 58, 58, 58, 56, 56, 56, 56, 56,
+	56, 56, 56, 56, 57, 57, 57, 57, 57, 57,
+	57, 57, 159, 159, 59, 59, 59, 59, 27, 27,
+	27, 27, 27, 110, 110, 111, 111, 111, 111, 111,
+	111, 111, 111, 111, 111, 111, 111, 111, 71, 71,
+	28, 28, 69, 69, 70, 72, 72, 68, 68, 68,
+	53, 53, 53, 53, 53, 53, 53, 53, 55, 55,
+	55, 73, 73, 74, 74, 75, 75, 76, 76, 77,
+	78, 78, 78, 79, 79, 79, 79, 80, 80, 80,
+	52, 52, 52, 52, 52, 52, 81, 81, 81, 81,
+	82, 82, 63, 63, 65, 65, 64, 66, 83, 83,
+	85, 86, 86, 89, 89, 90, 90, 87, 87, 91,
+	91, 91, 91, 91, 91, 91, 91, 91, 91, 91,
+	92, 92, 92, 93, 93, 94, 94, 94, 95, 95,
+	98, 98, 99, 99, 103, 103, 104, 104, 96, 96,
 	96, 96, 96, 96, 96, 96, 96, 96, 96, 96,
 	96, 96, 96, 96, 96, 96, 96, 96, 96, 96,
 	96, 96, 96, 96, 96, 96, 96, 96, 96, 96,
@@ -1476,13 +1523,14 @@ var yyR1 = [...]int{
 	96, 96, 96, 96, 96, 96, 96, 96, 96, 96,
 	96, 96, 96, 96, 96, 96, 96, 96, 96, 96,
 	96, 96, 96, 96, 96, 96, 96, 96, 96, 96,
-	96, 96, 97, 97, 97, 97, 97, 97, 97, 97,
+	96, 96, 96, 96, 96, 96, 97, 97, 97, 97,
+	97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
 	97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
 	97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
 	97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
 	97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
 	97, 97, 97, 97, 97, 97, 97, 97, 97, 97,
-	97, 97, 97, 149, 150, 108, 109, 109, 109,
+	97, 97, 97, 97, 155, 156, 108, 109, 109, 109,
 }
 var yyR2 = [...]int{
 
@@ -1491,47 +1539,48 @@ var yyR2 = [...]int{
 	7, 10, 1, 3, 1, 3, 6, 7, 1, 1,
 	8, 7, 6, 1, 1, 1, 3, 5, 3, 1,
 	2, 1, 1, 1, 2, 8, 4, 6, 4, 4,
-	1, 3, 3, 8, 3, 1, 1, 2, 1, 1,
-	1, 1, 1, 1, 1, 1, 2, 2, 2, 2,
-	2, 1, 2, 2, 2, 1, 4, 4, 2, 2,
-	3, 3, 3, 3, 1, 1, 1, 1, 1, 4,
-	1, 3, 0, 3, 0, 5, 0, 3, 5, 0,
-	1, 0, 1, 0, 1, 2, 0, 2, 2, 2,
-	2, 2, 0, 3, 0, 1, 0, 3, 3, 0,
-	2, 0, 2, 1, 2, 1, 0, 2, 4, 2,
-	3, 2, 2, 1, 1, 1, 3, 2, 0, 1,
-	3, 1, 2, 3, 1, 1, 1, 6, 7, 7,
-	4, 5, 7, 1, 3, 8, 8, 5, 4, 6,
-	5, 3, 2, 3, 1, 1, 1, 3, 2, 1,
-	2, 2, 2, 2, 2, 0, 2, 0, 2, 1,
-	2, 2, 0, 1, 1, 0, 1, 0, 1, 0,
-	1, 1, 3, 1, 2, 3, 5, 0, 1, 2,
-	1, 1, 0, 2, 1, 3, 1, 1, 1, 3,
-	3, 3, 3, 5, 5, 3, 0, 1, 0, 1,
-	2, 1, 1, 1, 2, 2, 1, 2, 3, 2,
-	3, 2, 2, 2, 1, 1, 3, 0, 5, 5,
-	5, 1, 3, 0, 2, 1, 3, 3, 2, 3,
-	1, 2, 0, 3, 1, 1, 3, 3, 4, 4,
-	5, 3, 4, 5, 6, 2, 1, 2, 1, 2,
-	1, 2, 1, 1, 1, 1, 1, 1, 1, 0,
-	2, 1, 1, 1, 3, 1, 3, 1, 1, 1,
-	1, 1, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 2, 2, 2,
-	2, 2, 2, 3, 1, 1, 1, 1, 4, 5,
-	6, 4, 4, 6, 6, 6, 9, 7, 5, 4,
-	2, 2, 2, 2, 2, 2, 2, 2, 0, 2,
-	4, 4, 4, 4, 0, 3, 4, 7, 3, 1,
-	1, 2, 3, 3, 1, 2, 2, 1, 2, 1,
-	2, 2, 1, 2, 0, 1, 0, 2, 1, 2,
-	4, 0, 2, 1, 3, 5, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 2, 2, 0, 3, 0,
-	2, 0, 3, 1, 3, 2, 0, 1, 1, 0,
-	2, 4, 4, 0, 2, 4, 2, 1, 3, 5,
-	4, 6, 1, 3, 3, 5, 0, 5, 1, 3,
-	1, 2, 3, 1, 1, 3, 3, 1, 1, 0,
-	2, 0, 3, 0, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 0, 1, 1, 1,
-	1, 0, 1, 1, 0, 2, 1, 1, 1, 1,
+	1, 3, 3, 3, 9, 3, 1, 1, 2, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 2,
+	2, 2, 1, 2, 2, 2, 1, 4, 4, 2,
+	2, 3, 3, 3, 3, 1, 1, 1, 1, 1,
+	4, 4, 1, 3, 0, 3, 0, 5, 0, 3,
+	5, 0, 1, 0, 1, 0, 6, 0, 2, 0,
+	1, 1, 0, 1, 2, 0, 2, 2, 2, 2,
+	2, 0, 3, 0, 1, 0, 3, 3, 0, 2,
+	0, 2, 1, 2, 1, 0, 2, 4, 11, 0,
+	2, 1, 3, 2, 3, 2, 2, 1, 1, 1,
+	3, 2, 0, 1, 3, 1, 2, 3, 1, 1,
+	1, 6, 7, 7, 4, 5, 7, 1, 3, 8,
+	8, 5, 4, 6, 5, 3, 2, 3, 1, 1,
+	1, 3, 2, 1, 2, 2, 2, 2, 2, 0,
+	2, 0, 2, 1, 2, 2, 0, 1, 1, 0,
+	1, 0, 1, 0, 1, 1, 3, 1, 2, 3,
+	5, 0, 1, 2, 1, 1, 0, 2, 1, 3,
+	1, 1, 1, 3, 3, 3, 3, 5, 5, 3,
+	0, 1, 0, 1, 2, 1, 1, 1, 2, 2,
+	1, 2, 3, 2, 3, 2, 2, 2, 1, 1,
+	3, 0, 5, 5, 5, 1, 3, 0, 2, 1,
+	3, 3, 2, 3, 1, 2, 0, 3, 1, 1,
+	3, 3, 4, 4, 5, 3, 4, 5, 6, 2,
+	1, 2, 1, 2, 1, 2, 1, 1, 1, 1,
+	1, 1, 1, 0, 2, 1, 1, 1, 3, 1,
+	3, 1, 1, 1, 1, 1, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 2, 2, 2, 2, 2, 2, 3, 1, 1,
+	1, 1, 4, 5, 6, 4, 4, 6, 6, 6,
+	9, 7, 5, 4, 2, 2, 2, 2, 2, 2,
+	2, 2, 0, 2, 4, 4, 4, 4, 0, 3,
+	4, 7, 3, 1, 1, 2, 3, 3, 1, 2,
+	2, 1, 2, 1, 2, 2, 1, 2, 0, 1,
+	0, 2, 1, 2, 4, 0, 2, 1, 3, 5,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+	2, 0, 3, 0, 2, 0, 3, 1, 3, 2,
+	0, 1, 1, 0, 2, 4, 4, 0, 2, 4,
+	2, 1, 3, 5, 4, 6, 1, 3, 3, 5,
+	0, 5, 1, 3, 1, 2, 3, 1, 1, 3,
+	3, 1, 1, 0, 2, 0, 3, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	0, 1, 1, 1, 1, 0, 1, 1, 0, 2,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -1547,215 +1596,224 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 0, 0, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 0, 0, 1, 1,
 }
 var yyChk = [...]int{
 
-	-1000, -147, -1, -2, -6, -7, -8, -9, -10, -11,
+	-1000, -153, -1, -2, -6, -7, -8, -9, -10, -11,
 	-12, -13, -14, -16, -17, -18, -19, -3, -4, 6,
 	-23, 8, 9, 29, -15, 109, 110, 112, 111, 130,
-	113, 123, 47, 24, 124, 125, 128, 129, -149, 7,
-	191, 50, -148, 204, -75, 14, -22, 5, -20, -152,
-	-20, -20, -20, -20, -136, 50, -94, 116, 67, 114,
-	120, -98, 53, -97, 197, 142, 136, 163, 155, 153,
-	156, 181, 62, 126, 151, 147, 145, 26, 168, 202,
-	146, 140, 141, 167, 199, 32, 134, 166, 162, 165,
-	139, 161, 36, 180, 158, 148, 17, 129, 132, 122,
-	201, 144, 133, 128, 35, 172, 138, 159, 135, 149,
-	150, 164, 137, 160, 173, 203, 157, 154, 177, 178,
-	179, 200, 152, 174, -87, 116, 118, 114, 114, 115,
-	116, 114, -43, -103, 53, -97, 116, 114, -112, 53,
-	-96, -97, 68, 21, 23, 170, 71, 103, 15, 72,
-	102, 192, 109, 45, 184, 185, 182, 183, 175, 28,
-	9, 24, 124, 20, 96, 111, 75, 76, 127, 22,
-	125, 66, 18, 48, 10, 12, 13, 119, 118, 87,
-	115, 43, 7, 105, 25, 84, 39, 27, 41, 85,
-	16, 186, 187, 30, 196, 131, 98, 46, 33, 69,
-	64, 49, 67, 14, 44, 86, 112, 191, 42, 6,
-	195, 29, 123, 40, 114, 176, 74, 117, 65, 5,
-	120, 8, 47, 121, 188, 189, 190, 31, 73, 11,
-	-103, -108, -108, -108, -108, -108, -2, -79, 16, 15,
-	-5, -3, -149, 6, 19, 20, -26, 37, 38, -21,
-	-87, -35, -36, -37, -38, -45, -67, -149, -43, 10,
-	-39, -43, -84, -83, 181, 156, 180, -85, -68, -98,
-	-103, 53, -97, -137, -133, 53, 115, -43, 191, -90,
-	119, 114, -43, -43, -89, 119, 53, -89, -43, 106,
-	-43, -108, -150, 52, -80, 18, 30, -33, -49, 69,
-	-54, 28, 22, -53, -50, -68, -66, -67, 103, 104,
-	92, 93, 100, 70, 105, -58, -56, -57, -59, 55,
-	54, 63, 56, 57, 58, 59, 64, 65, 66, -98,
-	-103, -64, -149, 41, 42, 192, 193, 196, 194, 72,
-	31, 182, 190, 189, 188, 186, 187, 184, 185, 119,
-	183, 98, 191, -76, -77, -33, -75, -2, -20, 33,
-	-24, 20, 61, -44, 25, -43, 29, 51, -40, -41,
-	-42, 39, 43, 45, 40, 41, 42, 46, -107, 21,
-	-35, -2, -149, -106, -105, 21, -103, 55, -43, -151,
-	51, 10, 121, -102, -99, 55, -98, -96, 51, 29,
-	77, 106, 52, 51, -113, -116, -118, -117, -114, -115,
-	153, 154, 103, 157, 159, 160, 161, 162, 163, 164,
-	165, 166, 167, 168, 126, 149, 150, 151, 152, 136,
-	137, 138, 139, 140, 141, 142, 144, 145, 146, 147,
-	148, 53, -109, -149, -99, 116, -43, 69, -43, -109,
-	117, -43, 22, 49, -43, -104, -103, -96, 8, 87,
-	68, 67, 84, 51, 17, -33, -51, 87, 69, 85,
-	86, 71, 89, 88, 99, 92, 93, 94, 95, 96,
-	97, 98, 90, 91, 102, 77, 78, 79, 80, 81,
-	82, 83, -88, -149, -67, -149, 107, 108, -54, -54,
-	-54, -54, -54, -54, -54, -149, 106, -62, -33, -149,
-	-149, -149, -149, -149, -149, -149, -71, -33, -149, -153,
-	-149, -153, -153, -153, -153, -153, -153, -153, -149, -149,
-	-149, -149, 51, -78, 23, 24, -79, -150, -26, -55,
-	-98, 56, 59, -25, 40, -52, 29, 31, -2, -149,
-	-43, -83, -36, -37, -36, -37, 39, 39, 39, 44,
-	39, 44, 39, -41, -103, -150, -150, -2, -46, 47,
-	118, 48, -105, -48, 11, -35, -43, -108, -85, -33,
-	-99, -104, -96, -138, -139, -140, -99, 55, 56, -133,
-	-134, -141, 122, 120, -135, 115, 27, -129, 64, 69,
-	-125, 173, -119, 50, -119, -119, -119, -119, -123, 156,
-	-123, -123, -123, 50, -119, -119, -119, -127, 50, -127,
-	-127, -128, 50, -128, -95, 121, -43, 22, -91, 112,
-	-146, 110, 170, 156, 62, 28, 111, 14, 192, 132,
-	203, 53, 133, -43, -43, -109, 35, -33, -33, -60,
-	64, 69, 65, 66, -33, -33, -54, -61, -64, -67,
-	60, 87, 85, 86, 71, -54, -54, -54, -54, -54,
+	113, 123, 47, 24, 124, 125, 128, 129, -155, 7,
+	198, 50, -154, 211, -75, 14, -22, 5, -20, -158,
+	-20, -20, -20, -20, -142, 50, -94, 116, 67, 114,
+	120, -98, 53, -97, 204, 137, 149, 143, 170, 162,
+	160, 163, 188, 62, 140, 126, 158, 154, 152, 26,
+	175, 209, 153, 141, 136, 147, 148, 174, 206, 32,
+	134, 173, 169, 172, 146, 168, 36, 187, 165, 155,
+	17, 129, 132, 122, 208, 151, 142, 133, 128, 35,
+	179, 145, 138, 166, 135, 156, 157, 171, 144, 167,
+	180, 210, 164, 161, 139, 184, 185, 186, 207, 159,
+	181, -87, 116, 118, 114, 114, 115, 116, 114, -43,
+	-103, 53, -97, 116, 114, -112, 53, -96, -97, 68,
+	21, 23, 177, 71, 103, 15, 72, 102, 199, 109,
+	45, 191, 192, 189, 190, 182, 28, 9, 24, 124,
+	20, 96, 111, 75, 76, 127, 22, 125, 66, 18,
+	48, 10, 12, 13, 119, 118, 87, 115, 43, 7,
+	105, 25, 84, 39, 27, 41, 85, 16, 193, 194,
+	30, 203, 131, 98, 46, 33, 69, 64, 49, 67,
+	14, 44, 86, 112, 198, 42, 6, 202, 29, 123,
+	40, 114, 183, 74, 117, 65, 5, 120, 8, 47,
+	121, 195, 196, 197, 31, 73, 11, -103, -108, -108,
+	-108, -108, -108, -2, -79, 16, 15, -5, -3, -155,
+	6, 19, 20, -26, 37, 38, -21, -87, -35, -36,
+	-37, -38, -45, -67, -155, -43, 10, -39, -43, -84,
+	-83, 188, 163, 187, -85, -68, -98, -103, 53, -97,
+	-143, -136, 53, 115, -43, 198, -90, 119, 114, -43,
+	-43, -89, 119, 53, -89, -43, 106, -43, -108, -156,
+	52, -80, 18, 30, -33, -49, 69, -54, 28, 22,
+	-53, -50, -68, -66, -67, 103, 104, 92, 93, 100,
+	70, 105, -58, -56, -57, -59, 55, 54, 63, 56,
+	57, 58, 59, 64, 65, 66, -98, -103, -64, -155,
+	41, 42, 199, 200, 203, 201, 72, 31, 189, 197,
+	196, 195, 193, 194, 191, 192, 119, 190, 98, 198,
+	-76, -77, -33, -75, -2, -20, 33, -24, 20, 61,
+	-44, 25, -43, 29, 51, -40, -41, -42, 39, 43,
+	45, 40, 41, 42, 46, -107, 21, -35, -2, -155,
+	-106, -105, 21, -103, 55, -43, -157, 51, 10, 121,
+	-102, -99, 55, -98, -96, 51, 29, 77, 106, 52,
+	51, -113, -116, -118, -117, -114, -115, 160, 161, 103,
+	164, 166, 167, 168, 169, 170, 171, 172, 173, 174,
+	175, 29, 126, 156, 157, 158, 159, 143, 144, 145,
+	146, 147, 148, 149, 151, 152, 153, 154, 155, 53,
+	-109, -155, -99, 116, -43, 69, -43, -109, 117, -43,
+	22, 49, -43, -104, -103, -96, 8, 87, 68, 67,
+	84, 51, 17, -33, -51, 87, 69, 85, 86, 71,
+	89, 88, 99, 92, 93, 94, 95, 96, 97, 98,
+	90, 91, 102, 77, 78, 79, 80, 81, 82, 83,
+	-88, -155, -67, -155, 107, 108, -54, -54, -54, -54,
+	-54, -54, -54, -155, 106, -62, -33, -155, -155, -155,
+	-155, -155, -155, -155, -71, -33, -155, -159, -155, -159,
+	-159, -159, -159, -159, -159, -159, -155, -155, -155, -155,
+	51, -78, 23, 24, -79, -156, -26, -55, -98, 56,
+	59, -25, 40, -52, 29, 31, -2, -155, -43, -83,
+	-36, -37, -36, -37, 39, 39, 39, 44, 39, 44,
+	39, -41, -103, -156, -156, -2, -46, 47, 118, 48,
+	-105, -48, 11, -35, -43, -108, -85, -33, -99, -104,
+	-96, -144, -145, -146, -99, 55, 56, -136, -137, -138,
+	-147, -139, 122, 120, -141, 140, 115, 27, -119, -120,
+	136, -127, 180, -121, 50, -121, -121, -121, -121, -125,
+	163, -125, -125, -125, 50, 50, -121, -121, -121, -129,
+	50, -129, -129, -130, 50, -130, -95, 121, -43, 22,
+	-91, 112, -152, 110, 177, 163, 62, 28, 111, 14,
+	199, 132, 210, 53, 133, -43, -43, -109, 35, -33,
+	-33, -60, 64, 69, 65, 66, -33, -33, -54, -61,
+	-64, -67, 60, 87, 85, 86, 71, -54, -54, -54,
 	-54, -54, -54, -54, -54, -54, -54, -54, -54, -54,
-	-110, 53, 55, 53, -53, -53, -98, -31, 20, -30,
-	-32, 94, -33, -103, -99, 51, -150, -30, -30, -33,
-	-33, -30, -24, -69, -70, 73, -98, -150, -30, -31,
-	-30, -30, -77, -80, -86, 18, 10, 31, 31, -30,
-	-82, 49, -83, -63, -65, -64, -149, -2, -81, -98,
-	-48, 49, 49, 39, 39, -150, 115, 115, 115, -75,
-	-33, -48, 106, 51, -140, 77, 50, 27, -135, 53,
-	53, -120, 28, 64, -126, 174, 56, -123, -123, -124,
-	102, 29, -124, -124, -124, -132, 55, 56, 56, 49,
-	-98, -109, -108, -92, -93, 117, 21, 115, 27, 132,
-	-109, 36, 64, 65, 66, -61, -54, -54, -54, -29,
-	127, 68, -150, -150, -30, 51, -101, -100, 21, -98,
-	55, 106, -149, -33, -150, -150, 51, 121, 21, -150,
-	-30, -72, -70, 75, -33, -150, -150, -150, -150, -150,
-	-43, -34, 10, 26, -82, 51, -150, -150, -150, 51,
-	106, -75, -33, -33, -149, -149, -149, -79, -99, -139,
-	-140, -143, -142, -98, 53, -122, 49, 55, 56, 57,
-	64, 182, 52, -124, -124, 53, 53, 103, 52, 51,
-	51, 52, 51, -43, -43, -108, -98, -29, 68, -54,
-	-54, -150, -32, -100, 94, -104, -31, -111, 103, 153,
-	126, 151, 147, 167, 158, 172, 149, 173, -110, -111,
-	197, -75, 76, -33, 74, -48, -35, 27, -65, 31,
-	-2, -149, -98, -98, -79, -47, -98, -47, -47, 52,
-	51, -119, -130, 170, 8, 55, 56, 56, -109, 25,
-	-54, 106, -150, -150, -119, -119, -119, -128, -119, 141,
-	-119, 141, -150, -150, -149, -28, 195, -33, -73, 12,
-	8, -63, -2, 106, -150, 51, -150, -150, -142, -131,
-	122, 27, 120, 182, 52, 52, -149, 94, -123, 53,
-	-54, -150, 55, -74, 13, 15, -83, -150, -98, -98,
-	-121, 62, 27, 27, -144, -145, 132, -27, 87, 200,
-	-33, -62, 55, -150, 51, -98, -150, 198, 46, 201,
-	-145, 31, 36, 199, 202, 134, 36, 135, 200, -149,
-	201, -54, 131, 202, -150, -150,
+	-54, -54, -110, 53, 55, 53, -53, -53, -98, -31,
+	20, -30, -32, 94, -33, -103, -99, 51, -156, -30,
+	-30, -33, -33, -30, -24, -69, -70, 73, -98, -156,
+	-30, -31, -30, -30, -77, -80, -86, 18, 10, 31,
+	31, -30, -82, 49, -83, -63, -65, -64, -155, -2,
+	-81, -98, -48, 49, 49, 39, 39, -156, 115, 115,
+	115, -75, -33, -48, 106, 51, -146, 77, 50, 141,
+	27, -141, 53, 53, 53, -131, 64, 69, 21, 137,
+	-128, 181, 56, -125, -125, -126, 102, 29, -126, -126,
+	-126, -135, 55, -135, 56, 56, 49, -98, -109, -108,
+	-92, -93, 117, 21, 115, 27, 132, -109, 36, 64,
+	65, 66, -61, -54, -54, -54, -29, 127, 68, -156,
+	-156, -30, 51, -101, -100, 21, -98, 55, 106, -155,
+	-33, -156, -156, 51, 121, 21, -156, -30, -72, -70,
+	75, -33, -156, -156, -156, -156, -156, -43, -34, 10,
+	26, -82, 51, -156, -156, -156, 51, 106, -75, -33,
+	-33, -155, -155, -155, -79, -99, -145, -146, -149, -148,
+	-98, 27, 53, -122, 28, 64, -155, 52, -126, -126,
+	53, 53, 103, 52, 51, 52, 51, 52, 51, -43,
+	-43, -108, -98, -29, 68, -54, -54, -156, -32, -100,
+	94, -104, -31, -111, 103, 160, 126, 158, 154, 174,
+	165, 179, 156, 180, -110, -111, 204, -75, 76, -33,
+	74, -48, -35, 27, -65, 31, -2, -155, -98, -98,
+	-79, -47, -98, -47, -47, 52, 51, -121, 50, -124,
+	49, 55, 56, 57, 64, 189, -33, 55, 56, 56,
+	-109, 25, -54, 106, -156, -156, -121, -121, -121, -130,
+	-121, 148, -121, 148, -156, -156, -155, -28, 202, -33,
+	-73, 12, 8, -63, -2, 106, -156, 51, -156, -156,
+	-148, -140, -98, -132, 177, 8, -156, 52, 52, -155,
+	94, -125, 53, -54, -156, 55, -74, 13, 15, -83,
+	-156, -98, -98, 52, 51, -134, 122, 27, 120, 189,
+	-133, 139, 138, -150, -151, 132, -27, 87, 207, -33,
+	-62, 142, -98, -123, 62, 27, 27, -156, 51, -98,
+	-156, 205, 46, 208, -43, 55, -151, 31, 36, 206,
+	209, 50, 134, 36, -140, 135, 207, 52, -155, 208,
+	-54, 131, 209, -156, -156,
 }
 var yyDef = [...]int{
 
 	0, -2, 2, -2, 5, 6, 7, 8, 9, 10,
-	11, 12, 13, 14, 15, 16, 17, 391, 0, 175,
-	175, 175, 175, 175, 0, 451, 433, 0, 0, 0,
-	0, 0, 169, 615, 615, 615, 615, 615, 0, 28,
-	29, 613, 1, 3, 399, 0, 0, 179, 182, 177,
-	433, 0, 0, 0, 44, 0, 0, 606, 0, 431,
-	452, 453, 456, 457, 552, 553, 554, 555, 556, 557,
-	558, 559, 560, 561, 562, 563, 564, 565, 566, 567,
-	568, 569, 570, 571, 572, 573, 574, 575, 576, 577,
-	578, 579, 580, 581, 582, 583, 584, 585, 586, 587,
-	588, 589, 590, 591, 592, 593, 594, 595, 596, 597,
-	598, 599, 600, 601, 602, 603, 604, 605, 607, 608,
-	609, 610, 611, 612, 0, 0, 434, 0, 429, 0,
-	429, 0, 162, 235, 460, 461, 606, 0, 615, 164,
-	165, 166, 464, 465, 466, 467, 468, 469, 470, 471,
-	472, 473, 474, 475, 476, 477, 478, 479, 480, 481,
-	482, 483, 484, 485, 486, 487, 488, 489, 490, 491,
-	492, 493, 494, 495, 496, 497, 498, 499, 500, 501,
-	502, 503, 504, 505, 506, 507, 508, 509, 510, 511,
-	512, 513, 514, 515, 516, 517, 518, 519, 520, 521,
-	522, 523, 524, 525, 526, 527, 528, 529, 530, 531,
-	532, 533, 534, 535, 536, 537, 538, 539, 540, 541,
-	542, 543, 544, 545, 546, 547, 548, 549, 550, 551,
-	168, 170, 171, 172, 173, 174, 22, 403, 0, 0,
-	391, 24, 0, 175, 180, 181, 185, 183, 184, 176,
-	0, 0, 204, 206, 207, 208, 216, 0, 218, 0,
-	0, 35, 0, 38, -2, 558, -2, 424, 0, 373,
-	0, -2, -2, 0, 50, 0, 0, 616, 0, 0,
-	0, 0, 616, 0, 0, 0, 0, 0, 161, 0,
-	163, 167, 23, 614, 18, 0, 0, 400, 245, 0,
-	250, 252, 0, 287, 288, 289, 290, 291, 0, 0,
-	0, 0, 0, 0, 0, 314, 315, 316, 317, 376,
-	377, 378, 379, 380, 381, 382, 383, 254, 255, 373,
-	0, 423, 0, 0, 0, 0, 0, 0, 0, 364,
-	0, 338, 338, 338, 338, 338, 338, 338, 338, 0,
-	0, 0, 0, 392, 393, 396, 399, 22, 182, 0,
-	187, 186, 178, 0, 0, 234, 0, 0, 0, 0,
-	0, 223, 0, 0, 226, 0, 0, 0, 0, 217,
-	0, 22, 0, 237, 219, 0, 221, 222, 243, 0,
-	0, 33, 34, 615, 42, 43, 458, 459, 0, 40,
-	0, 0, 138, 0, 103, 99, 55, 56, 92, 58,
-	92, 92, 92, 92, 116, 116, 116, 116, 84, 85,
-	86, 87, 88, 0, 71, 92, 92, 92, 75, 59,
-	60, 61, 62, 63, 64, 65, 94, 94, 94, 96,
-	96, 454, 46, 617, 618, 0, 48, 0, 0, 150,
-	0, 158, 430, 0, 616, 236, 462, 463, 404, 0,
-	0, 0, 0, 0, 0, 248, 0, 0, 0, 0,
+	11, 12, 13, 14, 15, 16, 17, 405, 0, 189,
+	189, 189, 189, 189, 0, 465, 447, 0, 0, 0,
+	0, 0, 183, 636, 636, 636, 636, 636, 0, 28,
+	29, 634, 1, 3, 413, 0, 0, 193, 196, 191,
+	447, 0, 0, 0, 44, 0, 0, 626, 0, 445,
+	466, 467, 470, 471, 566, 567, 568, 569, 570, 571,
+	572, 573, 574, 575, 576, 577, 578, 579, 580, 581,
+	582, 583, 584, 585, 586, 587, 588, 589, 590, 591,
+	592, 593, 594, 595, 596, 597, 598, 599, 600, 601,
+	602, 603, 604, 605, 606, 607, 608, 609, 610, 611,
+	612, 613, 614, 615, 616, 617, 618, 619, 620, 621,
+	622, 623, 624, 625, 627, 628, 629, 630, 631, 632,
+	633, 0, 0, 448, 0, 443, 0, 443, 0, 176,
+	249, 474, 475, 626, 0, 636, 178, 179, 180, 478,
+	479, 480, 481, 482, 483, 484, 485, 486, 487, 488,
+	489, 490, 491, 492, 493, 494, 495, 496, 497, 498,
+	499, 500, 501, 502, 503, 504, 505, 506, 507, 508,
+	509, 510, 511, 512, 513, 514, 515, 516, 517, 518,
+	519, 520, 521, 522, 523, 524, 525, 526, 527, 528,
+	529, 530, 531, 532, 533, 534, 535, 536, 537, 538,
+	539, 540, 541, 542, 543, 544, 545, 546, 547, 548,
+	549, 550, 551, 552, 553, 554, 555, 556, 557, 558,
+	559, 560, 561, 562, 563, 564, 565, 182, 184, 185,
+	186, 187, 188, 22, 417, 0, 0, 405, 24, 0,
+	189, 194, 195, 199, 197, 198, 190, 0, 0, 218,
+	220, 221, 222, 230, 0, 232, 0, 0, 35, 0,
+	38, -2, 573, -2, 438, 0, 387, 0, -2, -2,
+	0, 50, 0, 0, 637, 0, 0, 0, 0, 637,
+	0, 0, 0, 0, 0, 175, 0, 177, 181, 23,
+	635, 18, 0, 0, 414, 259, 0, 264, 266, 0,
+	301, 302, 303, 304, 305, 0, 0, 0, 0, 0,
+	0, 0, 328, 329, 330, 331, 390, 391, 392, 393,
+	394, 395, 396, 397, 268, 269, 387, 0, 437, 0,
+	0, 0, 0, 0, 0, 0, 378, 0, 352, 352,
+	352, 352, 352, 352, 352, 352, 0, 0, 0, 0,
+	406, 407, 410, 413, 22, 196, 0, 201, 200, 192,
+	0, 0, 248, 0, 0, 0, 0, 0, 237, 0,
+	0, 240, 0, 0, 0, 0, 231, 0, 22, 0,
+	251, 233, 0, 235, 236, 257, 0, 0, 33, 34,
+	636, 42, 43, 472, 473, 0, 40, 0, 0, 152,
+	139, -2, 101, 56, 57, 94, 59, 94, 94, 94,
+	94, 125, 125, 125, 125, 85, 86, 87, 88, 89,
+	0, 0, 72, 94, 94, 94, 76, 60, 61, 62,
+	63, 64, 65, 66, 96, 96, 96, 98, 98, 468,
+	46, 638, 639, 0, 48, 0, 0, 164, 0, 172,
+	444, 0, 637, 250, 476, 477, 418, 0, 0, 0,
+	0, 0, 0, 262, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 272, 273, 274, 275, 276,
-	277, 278, 251, 0, 265, 0, 0, 0, 307, 308,
-	309, 310, 311, 312, 0, 189, 0, 0, 285, 0,
-	0, 0, 0, 0, 0, 185, 0, 365, 0, 330,
-	0, 331, 332, 333, 334, 335, 336, 337, 0, 189,
-	0, 0, 0, 395, 397, 398, 403, 25, 185, 0,
-	384, 0, 0, 0, 188, 416, 0, 0, -2, 0,
-	233, 243, 205, 212, 0, 215, 224, 225, 227, 0,
-	229, 0, 231, 232, 209, 210, 284, 22, 211, 0,
-	0, 0, 220, 391, 0, 243, 36, 37, 425, 426,
-	374, 0, -2, 49, 139, 141, 144, 145, 146, 51,
-	52, 0, 0, 0, 0, 133, 134, 106, 104, 0,
-	101, 100, 57, 0, 116, 116, 78, 79, 119, 0,
-	119, 119, 119, 0, 72, 73, 74, 66, 0, 67,
-	68, 69, 0, 70, 0, 0, 616, 432, 615, 446,
-	151, 435, 436, 437, 438, 439, 440, 441, 442, 443,
-	444, 445, 0, 157, 616, 160, 0, 246, 247, 249,
-	266, 0, 268, 270, 401, 402, 256, 257, 281, 282,
-	283, 0, 0, 0, 0, 279, 261, 0, 292, 293,
-	294, 295, 296, 297, 298, 299, 300, 301, 302, 303,
-	306, 349, 350, 0, 304, 305, 313, 0, 0, 190,
-	191, 193, 197, 0, 374, 0, 422, 0, 0, 0,
-	0, 0, 0, 371, 368, 0, 0, 339, 0, 0,
-	0, 0, 394, 19, 0, 427, 428, 385, 386, 202,
-	26, 0, 416, 406, 418, 420, 0, 22, 0, 412,
-	391, 0, 0, 228, 230, -2, 0, 0, 0, 399,
-	244, 32, 0, 0, 142, 0, 0, 129, 0, 131,
-	132, 112, 0, 105, 54, 102, 0, 119, 119, 80,
-	0, 0, 81, 82, 83, 0, 90, 0, 0, 0,
-	455, 47, 147, 0, 615, 447, 448, 449, 450, 0,
-	159, 405, 267, 269, 271, 258, 279, 262, 0, 259,
-	0, 0, 253, 318, 0, 0, 194, 198, 0, 200,
-	201, 0, 189, 286, 321, 322, 0, 0, 0, 0,
-	391, 0, 369, 0, 0, 329, 340, 341, 342, 343,
-	20, 243, 0, 0, 27, 0, 421, -2, 0, 0,
-	0, 399, 213, 214, 0, 0, 0, 31, 375, 140,
-	143, 0, 135, 92, 130, 114, 0, 107, 108, 109,
-	110, 111, 93, 76, 77, 120, 117, 118, 89, 0,
-	0, 97, 0, 616, 148, 149, 0, 260, 0, 280,
-	263, 319, 192, 199, 195, 0, 0, 0, 92, 92,
-	354, 92, 96, 357, 92, 359, 92, 362, 0, 0,
-	0, 366, 328, 372, 0, 387, 203, 0, 419, 0,
-	-2, 0, 414, 413, 30, 0, 241, 0, 0, 128,
-	0, 137, 121, 115, 0, 91, 0, 0, 45, 0,
-	264, 0, 320, 323, 351, 116, 355, 356, 358, 360,
-	361, 363, 325, 324, 0, 0, 0, 370, 389, 0,
-	0, 409, 22, 0, 238, 0, 239, 240, 136, 126,
-	0, 123, 125, 113, 95, 98, 0, 196, 352, 353,
-	344, 327, 367, 21, 0, 0, 417, -2, 415, 242,
-	53, 0, 122, 124, 0, 153, 0, 0, 0, 0,
-	390, 388, 127, 152, 0, 0, 326, 0, 0, 0,
-	154, 0, 345, 0, 348, 0, 346, 0, 0, 0,
-	0, 0, 0, 347, 155, 156,
+	0, 0, 0, 286, 287, 288, 289, 290, 291, 292,
+	265, 0, 279, 0, 0, 0, 321, 322, 323, 324,
+	325, 326, 0, 203, 0, 0, 299, 0, 0, 0,
+	0, 0, 0, 199, 0, 379, 0, 344, 0, 345,
+	346, 347, 348, 349, 350, 351, 0, 203, 0, 0,
+	0, 409, 411, 412, 417, 25, 199, 0, 398, 0,
+	0, 0, 202, 430, 0, 0, -2, 0, 247, 257,
+	219, 226, 0, 229, 238, 239, 241, 0, 243, 0,
+	245, 246, 223, 224, 298, 22, 225, 0, 0, 0,
+	234, 405, 0, 257, 36, 37, 439, 440, 388, 0,
+	-2, 49, 153, 155, 158, 159, 160, 51, 52, 53,
+	0, 0, 0, 0, 0, 0, 147, 148, 112, 0,
+	0, 103, 102, 58, 0, 125, 125, 79, 80, 128,
+	0, 128, 128, 128, 0, 0, 73, 74, 75, 67,
+	0, 68, 69, 70, 0, 71, 0, 0, 637, 446,
+	636, 460, 165, 449, 450, 451, 452, 453, 454, 455,
+	456, 457, 458, 459, 0, 171, 637, 174, 0, 260,
+	261, 263, 280, 0, 282, 284, 415, 416, 270, 271,
+	295, 296, 297, 0, 0, 0, 0, 293, 275, 0,
+	306, 307, 308, 309, 310, 311, 312, 313, 314, 315,
+	316, 317, 320, 363, 364, 0, 318, 319, 327, 0,
+	0, 204, 205, 207, 211, 0, 388, 0, 436, 0,
+	0, 0, 0, 0, 0, 385, 382, 0, 0, 353,
+	0, 0, 0, 0, 408, 19, 0, 441, 442, 399,
+	400, 216, 26, 0, 430, 420, 432, 434, 0, 22,
+	0, 426, 405, 0, 0, 242, 244, -2, 0, 0,
+	0, 413, 258, 32, 0, 0, 156, 0, 0, 0,
+	143, 0, 145, 146, 140, 115, 113, 0, 0, 108,
+	55, 104, 0, 128, 128, 81, 0, 0, 82, 83,
+	84, 0, 92, 0, 0, 0, 0, 469, 47, 161,
+	0, 636, 461, 462, 463, 464, 0, 173, 419, 281,
+	283, 285, 272, 293, 276, 0, 273, 0, 0, 267,
+	332, 0, 0, 208, 212, 0, 214, 215, 0, 203,
+	300, 335, 336, 0, 0, 0, 0, 405, 0, 383,
+	0, 0, 343, 354, 355, 356, 357, 20, 257, 0,
+	0, 27, 0, 435, -2, 0, 0, 0, 413, 227,
+	228, 0, 0, 0, 31, 389, 154, 157, 0, 149,
+	94, 0, 144, 121, 0, 114, 0, 95, 77, 78,
+	129, 126, 127, 90, 0, 91, 0, 99, 0, 637,
+	162, 163, 0, 274, 0, 294, 277, 333, 206, 213,
+	209, 0, 0, 0, 94, 94, 368, 94, 98, 371,
+	94, 373, 94, 376, 0, 0, 0, 380, 342, 386,
+	0, 401, 217, 0, 433, 0, -2, 0, 428, 427,
+	30, 0, 255, 0, 0, 137, 0, 151, 0, 123,
+	0, 116, 117, 118, 119, 120, 0, 93, 0, 0,
+	45, 0, 278, 0, 334, 337, 365, 125, 369, 370,
+	372, 374, 375, 377, 339, 338, 0, 0, 0, 384,
+	403, 0, 0, 423, 22, 0, 252, 0, 253, 254,
+	150, 0, 141, 130, 124, 0, 109, 97, 100, 0,
+	210, 366, 367, 358, 341, 381, 21, 0, 0, 431,
+	-2, 429, 256, 0, 0, 135, 0, 132, 134, 122,
+	106, 110, 111, 0, 167, 0, 0, 0, 0, 404,
+	402, 0, 142, 54, 0, 131, 133, 166, 0, 0,
+	340, 0, 0, 0, 0, 136, 168, 0, 359, 0,
+	362, 0, 0, 360, 0, 0, 0, 138, 0, 0,
+	0, 0, 361, 169, 170,
 }
 var yyTok1 = [...]int{
 
@@ -1764,7 +1822,7 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 70, 3, 3, 3, 97, 89, 3,
 	50, 52, 94, 92, 51, 93, 106, 95, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 204,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 211,
 	78, 77, 79, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -1793,7 +1851,8 @@ var yyTok2 = [...]int{
 	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
 	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
 	189, 190, 191, 192, 193, 194, 195, 196, 197, 198,
-	199, 200, 201, 202, 203,
+	199, 200, 201, 202, 203, 204, 205, 206, 207, 208,
+	209, 210,
 }
 var yyTok3 = [...]int{
 	0,
@@ -2138,29 +2197,29 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:273
+		//line sql.y:281
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:278
+		//line sql.y:286
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:279
+		//line sql.y:287
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:283
+		//line sql.y:291
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
 	case 18:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:302
+		//line sql.y:310
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -2170,49 +2229,49 @@ yydefault:
 		}
 	case 19:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:310
+		//line sql.y:318
 		{
 			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
 		}
 	case 20:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:314
+		//line sql.y:322
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
 		}
 	case 21:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line sql.y:321
+		//line sql.y:329
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
 		}
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:327
+		//line sql.y:335
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
 	case 23:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:331
+		//line sql.y:339
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:337
+		//line sql.y:345
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
 	case 25:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:341
+		//line sql.y:349
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
 	case 26:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:348
+		//line sql.y:356
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[5].ins
@@ -2225,7 +2284,7 @@ yydefault:
 		}
 	case 27:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:359
+		//line sql.y:367
 		{
 			cols := make(Columns, 0, len(yyDollar[6].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[7].updateExprs))
@@ -2237,225 +2296,225 @@ yydefault:
 		}
 	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:371
+		//line sql.y:379
 		{
 			yyVAL.str = InsertStr
 		}
 	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:375
+		//line sql.y:383
 		{
 			yyVAL.str = ReplaceStr
 		}
 	case 30:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:381
+		//line sql.y:389
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), TableExprs: yyDollar[3].tableExprs, Exprs: yyDollar[5].updateExprs, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
 	case 31:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:387
+		//line sql.y:395
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Where: NewWhere(WhereStr, yyDollar[5].expr), OrderBy: yyDollar[6].orderBy, Limit: yyDollar[7].limit}
 		}
 	case 32:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:391
+		//line sql.y:399
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
 	case 33:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:396
+		//line sql.y:404
 		{
 		}
 	case 34:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:397
+		//line sql.y:405
 		{
 		}
 	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:401
+		//line sql.y:409
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
 	case 36:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:405
+		//line sql.y:413
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
 	case 37:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:411
+		//line sql.y:419
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Charset: yyDollar[4].colIdent}
 		}
 	case 38:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:415
+		//line sql.y:423
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].updateExprs}
 		}
 	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:426
+		//line sql.y:434
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
 	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:430
+		//line sql.y:438
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
 	case 44:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:436
+		//line sql.y:444
 		{
 			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
 			yyVAL.statement = yyDollar[1].ddl
 		}
 	case 45:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:441
+		//line sql.y:449
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName, NewName: yyDollar[7].tableName}
 		}
 	case 46:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:446
+		//line sql.y:454
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, NewName: yyDollar[3].tableName.ToViewName()}
 		}
 	case 47:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:450
+		//line sql.y:458
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, NewName: yyDollar[5].tableName.ToViewName()}
 		}
 	case 48:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:456
+		//line sql.y:464
 		{
 			yyVAL.ddl = &DDL{Action: CreateStr, NewName: yyDollar[4].tableName}
 			setDDL(yylex, yyVAL.ddl)
 		}
 	case 49:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:463
+		//line sql.y:471
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].str
 		}
 	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:470
+		//line sql.y:478
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
 	case 51:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:475
+		//line sql.y:483
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
 	case 52:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:479
+		//line sql.y:487
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
 	case 53:
-		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:485
-		{
-			yyDollar[2].columnType.NotNull = yyDollar[3].boolVal
-			yyDollar[2].columnType.Default = yyDollar[4].optVal
-			yyDollar[2].columnType.OnUpdate = yyDollar[5].optVal
-			yyDollar[2].columnType.Autoincrement = yyDollar[6].boolVal
-			yyDollar[2].columnType.KeyOpt = yyDollar[7].colKeyOpt
-			yyDollar[2].columnType.Comment = yyDollar[8].optVal
-			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:491
+		{
+			yyVAL.TableSpec.AddForeignKey(yyDollar[3].foreignKeyDefinition)
 		}
 	case 54:
+		yyDollar = yyS[yypt-9 : yypt+1]
+		//line sql.y:497
+		{
+			yyDollar[2].columnType.GeneratedExpr = yyDollar[3].columnType.GeneratedExpr
+			yyDollar[2].columnType.Stored = yyDollar[3].columnType.Stored
+			yyDollar[2].columnType.NotNull = yyDollar[4].boolVal
+			yyDollar[2].columnType.Default = yyDollar[5].optVal
+			yyDollar[2].columnType.OnUpdate = yyDollar[6].optVal
+			yyDollar[2].columnType.Autoincrement = yyDollar[7].boolVal
+			yyDollar[2].columnType.KeyOpt = yyDollar[8].colKeyOpt
+			yyDollar[2].columnType.Comment = yyDollar[9].optVal
+			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
+		}
+	case 55:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:496
+		//line sql.y:510
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
 			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
 		}
-	case 57:
+	case 58:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:506
+		//line sql.y:520
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].optVal
 		}
-	case 58:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:511
-		{
-			yyVAL.columnType = yyDollar[1].columnType
-		}
 	case 59:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:517
+		//line sql.y:525
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 60:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:521
+		//line sql.y:531
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:525
+		//line sql.y:535
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:529
+		//line sql.y:539
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:533
+		//line sql.y:543
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:537
+		//line sql.y:547
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:541
+		//line sql.y:551
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 66:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:547
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:555
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
-			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
-			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 67:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:553
+		//line sql.y:561
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -2463,7 +2522,7 @@ yydefault:
 		}
 	case 68:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:559
+		//line sql.y:567
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -2471,7 +2530,7 @@ yydefault:
 		}
 	case 69:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:565
+		//line sql.y:573
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -2479,548 +2538,634 @@ yydefault:
 		}
 	case 70:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:571
+		//line sql.y:579
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 71:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:579
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:585
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 72:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:583
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:593
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 73:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:587
+		//line sql.y:597
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
 	case 74:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:591
+		//line sql.y:601
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
 	case 75:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:595
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:605
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
 	case 76:
-		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:601
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:609
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 77:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:605
+		//line sql.y:615
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
 	case 78:
-		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:609
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line sql.y:619
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
 	case 79:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:613
+		//line sql.y:623
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
 	case 80:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:617
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:627
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
 	case 81:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:621
+		//line sql.y:631
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 82:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:625
+		//line sql.y:635
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 83:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:629
+		//line sql.y:639
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 84:
-		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:633
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:643
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:637
+		//line sql.y:647
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:641
+		//line sql.y:651
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:645
+		//line sql.y:655
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:649
+		//line sql.y:659
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
 	case 89:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:663
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
+		}
+	case 90:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:653
+		//line sql.y:667
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs}
 		}
-	case 90:
+	case 91:
+		yyDollar = yyS[yypt-4 : yypt+1]
+		//line sql.y:671
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs}
+		}
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:659
+		//line sql.y:677
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 91:
+	case 93:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:664
+		//line sql.y:682
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 92:
+	case 94:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:669
+		//line sql.y:687
 		{
 			yyVAL.optVal = nil
 		}
-	case 93:
+	case 95:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:673
+		//line sql.y:691
 		{
 			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 94:
+	case 96:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:678
+		//line sql.y:696
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 95:
+	case 97:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:682
+		//line sql.y:700
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 96:
+	case 98:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:690
+		//line sql.y:708
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 97:
+	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:694
+		//line sql.y:712
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 			}
 		}
-	case 98:
+	case 100:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:700
+		//line sql.y:718
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 99:
+	case 101:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:708
+		//line sql.y:726
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 100:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:712
+		//line sql.y:730
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 101:
+	case 103:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:717
+		//line sql.y:735
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 102:
+	case 104:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:721
+		//line sql.y:739
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 103:
+	case 105:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		//line sql.y:745
+		{
+			yyVAL.columnType = ColumnType{}
+		}
+	case 106:
+		yyDollar = yyS[yypt-6 : yypt+1]
+		//line sql.y:749
+		{
+			yyVAL.columnType = ColumnType{GeneratedExpr: yyDollar[4].expr, Stored: yyDollar[6].boolVal}
+		}
+	case 107:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		//line sql.y:754
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 108:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:756
+		{
+			yyVAL.empty = struct{}{}
+		}
+	case 109:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:727
+		//line sql.y:760
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 104:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:731
+		//line sql.y:764
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 105:
+	case 111:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:768
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 112:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		//line sql.y:774
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 113:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:778
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 114:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:735
+		//line sql.y:782
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 106:
+	case 115:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:740
+		//line sql.y:787
 		{
 			yyVAL.optVal = nil
 		}
-	case 107:
+	case 116:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:744
+		//line sql.y:791
 		{
 			yyVAL.optVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 108:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:748
+		//line sql.y:795
 		{
 			yyVAL.optVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 109:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:752
+		//line sql.y:799
 		{
 			yyVAL.optVal = NewFloatVal(yyDollar[2].bytes)
 		}
-	case 110:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:756
+		//line sql.y:803
 		{
 			yyVAL.optVal = NewValArg(yyDollar[2].bytes)
 		}
-	case 111:
+	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:760
+		//line sql.y:807
 		{
 			yyVAL.optVal = NewValArg(yyDollar[2].bytes)
 		}
-	case 112:
+	case 121:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:765
+		//line sql.y:812
 		{
 			yyVAL.optVal = nil
 		}
-	case 113:
+	case 122:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:769
+		//line sql.y:816
 		{
 			yyVAL.optVal = NewValArg(yyDollar[3].bytes)
 		}
-	case 114:
+	case 123:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:774
+		//line sql.y:821
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 115:
+	case 124:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:778
+		//line sql.y:825
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 116:
+	case 125:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:783
+		//line sql.y:830
 		{
 			yyVAL.str = ""
 		}
-	case 117:
+	case 126:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:787
+		//line sql.y:834
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 118:
+	case 127:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:791
+		//line sql.y:838
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 119:
+	case 128:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:796
+		//line sql.y:843
 		{
 			yyVAL.str = ""
 		}
-	case 120:
+	case 129:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:800
+		//line sql.y:847
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 121:
+	case 130:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:805
+		//line sql.y:852
 		{
 			yyVAL.colKeyOpt = colKeyNone
 		}
-	case 122:
+	case 131:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:809
+		//line sql.y:856
 		{
 			yyVAL.colKeyOpt = colKeyPrimary
 		}
-	case 123:
+	case 132:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:813
+		//line sql.y:860
 		{
 			yyVAL.colKeyOpt = colKey
 		}
-	case 124:
+	case 133:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:817
+		//line sql.y:864
 		{
 			yyVAL.colKeyOpt = colKeyUniqueKey
 		}
-	case 125:
+	case 134:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:821
+		//line sql.y:868
 		{
 			yyVAL.colKeyOpt = colKeyUnique
 		}
-	case 126:
+	case 135:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:826
+		//line sql.y:873
 		{
 			yyVAL.optVal = nil
 		}
-	case 127:
+	case 136:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:830
+		//line sql.y:877
 		{
 			yyVAL.optVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 128:
+	case 137:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:836
+		//line sql.y:883
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
 		}
-	case 129:
+	case 138:
+		yyDollar = yyS[yypt-11 : yypt+1]
+		//line sql.y:889
+		{
+			yyVAL.foreignKeyDefinition = &ForeignKeyDefinition{Name: yyDollar[1].colIdent, Source: yyDollar[5].columns, ReferencedTable: yyDollar[8].tableName, ReferencedColumns: yyDollar[10].columns}
+		}
+	case 139:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		//line sql.y:894
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 140:
+		yyDollar = yyS[yypt-2 : yypt+1]
+		//line sql.y:898
+		{
+			yyVAL.colIdent = NewColIdent(string(yyDollar[2].bytes))
+		}
+	case 141:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		//line sql.y:904
+		{
+			yyVAL.columns = Columns{yyDollar[1].colIdent}
+		}
+	case 142:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		//line sql.y:908
+		{
+			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
+		}
+	case 143:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:842
+		//line sql.y:914
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 130:
+	case 144:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:846
+		//line sql.y:918
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(string(yyDollar[3].bytes)), Unique: true}
 		}
-	case 131:
+	case 145:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:850
+		//line sql.y:922
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: true}
 		}
-	case 132:
+	case 146:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:854
+		//line sql.y:926
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(string(yyDollar[2].bytes)), Unique: false}
 		}
-	case 133:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:860
+		//line sql.y:932
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 134:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:864
+		//line sql.y:936
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 135:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:870
+		//line sql.y:942
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 136:
+	case 150:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:874
+		//line sql.y:946
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 137:
+	case 151:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:880
+		//line sql.y:952
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].optVal}
 		}
-	case 138:
+	case 152:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:885
+		//line sql.y:957
 		{
 			yyVAL.str = ""
 		}
-	case 139:
+	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:889
+		//line sql.y:961
 		{
 			yyVAL.str = " " + string(yyDollar[1].str)
 		}
-	case 140:
+	case 154:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:893
+		//line sql.y:965
 		{
 			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
 		}
-	case 141:
+	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:901
+		//line sql.y:973
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 142:
+	case 156:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:905
+		//line sql.y:977
 		{
 			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 143:
+	case 157:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:909
+		//line sql.y:981
 		{
 			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
 		}
-	case 144:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:915
+		//line sql.y:987
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 145:
+	case 159:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:919
+		//line sql.y:991
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 146:
+	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:923
+		//line sql.y:995
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 147:
+	case 161:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:929
+		//line sql.y:1001
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
 		}
-	case 148:
+	case 162:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:933
+		//line sql.y:1005
 		{
 			// Change this to a rename statement
 			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[4].tableName, NewName: yyDollar[7].tableName}
 		}
-	case 149:
+	case 163:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:938
+		//line sql.y:1010
 		{
 			// Rename an index can just be an alter
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, NewName: yyDollar[4].tableName}
 		}
-	case 150:
+	case 164:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:943
+		//line sql.y:1015
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName(), NewName: yyDollar[3].tableName.ToViewName()}
 		}
-	case 151:
+	case 165:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:947
+		//line sql.y:1019
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
 		}
-	case 152:
+	case 166:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:953
+		//line sql.y:1025
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
 		}
-	case 153:
+	case 167:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:959
+		//line sql.y:1031
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 154:
+	case 168:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:963
+		//line sql.y:1035
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 155:
+	case 169:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:969
+		//line sql.y:1041
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 156:
+	case 170:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line sql.y:973
+		//line sql.y:1045
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 157:
+	case 171:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:979
+		//line sql.y:1051
 		{
 			yyVAL.statement = &DDL{Action: RenameStr, Table: yyDollar[3].tableName, NewName: yyDollar[5].tableName}
 		}
-	case 158:
+	case 172:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:985
+		//line sql.y:1057
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -3028,16 +3173,16 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableName, IfExists: exists}
 		}
-	case 159:
+	case 173:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:993
+		//line sql.y:1065
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName, NewName: yyDollar[5].tableName}
 		}
-	case 160:
+	case 174:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:998
+		//line sql.y:1070
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -3045,33 +3190,33 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, Table: yyDollar[4].tableName.ToViewName(), IfExists: exists}
 		}
-	case 161:
+	case 175:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1008
+		//line sql.y:1080
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
 		}
-	case 162:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1012
+		//line sql.y:1084
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
 		}
-	case 163:
+	case 177:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1017
+		//line sql.y:1089
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName, NewName: yyDollar[3].tableName}
 		}
-	case 164:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1023
+		//line sql.y:1095
 		{
 			yyVAL.str = ShowUnsupportedStr
 		}
-	case 165:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1027
+		//line sql.y:1099
 		{
 			switch v := string(yyDollar[1].bytes); v {
 			case ShowDatabasesStr, ShowTablesStr:
@@ -3080,9 +3225,9 @@ yydefault:
 				yyVAL.str = ShowUnsupportedStr
 			}
 		}
-	case 166:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1036
+		//line sql.y:1108
 		{
 			switch v := string(yyDollar[1].bytes); v {
 			case ShowKeyspacesStr, ShowShardsStr, ShowVSchemaTablesStr:
@@ -3091,376 +3236,376 @@ yydefault:
 				yyVAL.str = ShowUnsupportedStr
 			}
 		}
-	case 167:
+	case 181:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1047
+		//line sql.y:1119
 		{
 			yyVAL.statement = &Show{Type: yyDollar[2].str}
 		}
-	case 168:
+	case 182:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1053
+		//line sql.y:1125
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 169:
+	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1057
+		//line sql.y:1129
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 170:
+	case 184:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1063
+		//line sql.y:1135
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 171:
+	case 185:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1067
+		//line sql.y:1139
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 172:
+	case 186:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1071
+		//line sql.y:1143
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 173:
+	case 187:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1075
+		//line sql.y:1147
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 174:
+	case 188:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1079
+		//line sql.y:1151
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 175:
+	case 189:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1084
+		//line sql.y:1156
 		{
 			setAllowComments(yylex, true)
 		}
-	case 176:
+	case 190:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1088
+		//line sql.y:1160
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 177:
+	case 191:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1094
+		//line sql.y:1166
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 178:
+	case 192:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1098
+		//line sql.y:1170
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 179:
+	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1104
+		//line sql.y:1176
 		{
 			yyVAL.str = UnionStr
 		}
-	case 180:
+	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1108
+		//line sql.y:1180
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 181:
+	case 195:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1112
+		//line sql.y:1184
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 182:
+	case 196:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1117
+		//line sql.y:1189
 		{
 			yyVAL.str = ""
 		}
-	case 183:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1121
+		//line sql.y:1193
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 184:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1125
+		//line sql.y:1197
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 185:
+	case 199:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1130
+		//line sql.y:1202
 		{
 			yyVAL.str = ""
 		}
-	case 186:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1134
+		//line sql.y:1206
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 187:
+	case 201:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1139
+		//line sql.y:1211
 		{
 			yyVAL.str = ""
 		}
-	case 188:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1143
+		//line sql.y:1215
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 189:
+	case 203:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1148
+		//line sql.y:1220
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 190:
+	case 204:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1152
+		//line sql.y:1224
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 191:
+	case 205:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1158
+		//line sql.y:1230
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 192:
+	case 206:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1162
+		//line sql.y:1234
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 193:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1168
+		//line sql.y:1240
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 194:
+	case 208:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1172
+		//line sql.y:1244
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 195:
+	case 209:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1176
+		//line sql.y:1248
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 196:
+	case 210:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1180
+		//line sql.y:1252
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 197:
+	case 211:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1185
+		//line sql.y:1257
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 198:
+	case 212:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1189
+		//line sql.y:1261
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 199:
+	case 213:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1193
+		//line sql.y:1265
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 201:
+	case 215:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1200
+		//line sql.y:1272
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 202:
+	case 216:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1205
+		//line sql.y:1277
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 203:
+	case 217:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1209
+		//line sql.y:1281
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 204:
+	case 218:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1215
+		//line sql.y:1287
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 205:
+	case 219:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1219
+		//line sql.y:1291
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 208:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1229
+		//line sql.y:1301
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 209:
+	case 223:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1233
+		//line sql.y:1305
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 210:
+	case 224:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1237
+		//line sql.y:1309
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 211:
+	case 225:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1243
+		//line sql.y:1315
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 212:
+	case 226:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1256
+		//line sql.y:1328
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 213:
+	case 227:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1260
+		//line sql.y:1332
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, On: yyDollar[5].expr}
 		}
-	case 214:
+	case 228:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1264
+		//line sql.y:1336
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, On: yyDollar[5].expr}
 		}
-	case 215:
+	case 229:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1268
+		//line sql.y:1340
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 216:
+	case 230:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1273
+		//line sql.y:1345
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 217:
+	case 231:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1275
+		//line sql.y:1347
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 218:
+	case 232:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1278
+		//line sql.y:1350
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 219:
+	case 233:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1282
+		//line sql.y:1354
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 220:
+	case 234:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1286
+		//line sql.y:1358
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 222:
+	case 236:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1293
+		//line sql.y:1365
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 223:
+	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1299
+		//line sql.y:1371
 		{
 			yyVAL.str = JoinStr
 		}
-	case 224:
+	case 238:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1303
+		//line sql.y:1375
 		{
 			yyVAL.str = JoinStr
 		}
-	case 225:
+	case 239:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1307
+		//line sql.y:1379
 		{
 			yyVAL.str = JoinStr
 		}
-	case 226:
+	case 240:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1311
+		//line sql.y:1383
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 227:
+	case 241:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1317
+		//line sql.y:1389
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 228:
+	case 242:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1321
+		//line sql.y:1393
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 229:
+	case 243:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1325
+		//line sql.y:1397
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 230:
+	case 244:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1329
+		//line sql.y:1401
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 231:
+	case 245:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1335
+		//line sql.y:1407
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 232:
+	case 246:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1339
+		//line sql.y:1411
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -3468,465 +3613,465 @@ yydefault:
 				yyVAL.str = NaturalRightJoinStr
 			}
 		}
-	case 233:
+	case 247:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1349
+		//line sql.y:1421
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 234:
+	case 248:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1353
+		//line sql.y:1425
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 235:
+	case 249:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1359
+		//line sql.y:1431
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 236:
+	case 250:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1363
+		//line sql.y:1435
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 237:
+	case 251:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1368
+		//line sql.y:1440
 		{
 			yyVAL.indexHints = nil
 		}
-	case 238:
+	case 252:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1372
+		//line sql.y:1444
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].colIdents}
 		}
-	case 239:
+	case 253:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1376
+		//line sql.y:1448
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].colIdents}
 		}
-	case 240:
+	case 254:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1380
+		//line sql.y:1452
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].colIdents}
 		}
-	case 241:
+	case 255:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1386
+		//line sql.y:1458
 		{
 			yyVAL.colIdents = []ColIdent{yyDollar[1].colIdent}
 		}
-	case 242:
+	case 256:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1390
+		//line sql.y:1462
 		{
 			yyVAL.colIdents = append(yyDollar[1].colIdents, yyDollar[3].colIdent)
 		}
-	case 243:
+	case 257:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1395
+		//line sql.y:1467
 		{
 			yyVAL.expr = nil
 		}
-	case 244:
+	case 258:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1399
+		//line sql.y:1471
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 245:
+	case 259:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1405
+		//line sql.y:1477
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 246:
+	case 260:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1409
+		//line sql.y:1481
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 247:
+	case 261:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1413
+		//line sql.y:1485
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 248:
+	case 262:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1417
+		//line sql.y:1489
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 249:
+	case 263:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1421
+		//line sql.y:1493
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
-	case 250:
+	case 264:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1425
+		//line sql.y:1497
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 251:
+	case 265:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1429
+		//line sql.y:1501
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 252:
+	case 266:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1435
+		//line sql.y:1507
 		{
 			yyVAL.str = ""
 		}
-	case 253:
+	case 267:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1439
+		//line sql.y:1511
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 254:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1445
+		//line sql.y:1517
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 255:
+	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1449
+		//line sql.y:1521
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 256:
+	case 270:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1455
+		//line sql.y:1527
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
-	case 257:
+	case 271:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1459
+		//line sql.y:1531
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 258:
+	case 272:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1463
+		//line sql.y:1535
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 259:
+	case 273:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1467
+		//line sql.y:1539
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 260:
+	case 274:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1471
+		//line sql.y:1543
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 261:
+	case 275:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1475
+		//line sql.y:1547
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
-	case 262:
+	case 276:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1479
+		//line sql.y:1551
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
-	case 263:
+	case 277:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1483
+		//line sql.y:1555
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 264:
+	case 278:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1487
+		//line sql.y:1559
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 265:
+	case 279:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1491
+		//line sql.y:1563
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 266:
+	case 280:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1497
+		//line sql.y:1569
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 267:
+	case 281:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1501
+		//line sql.y:1573
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 268:
+	case 282:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1505
+		//line sql.y:1577
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 269:
+	case 283:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1509
+		//line sql.y:1581
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 270:
+	case 284:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1513
+		//line sql.y:1585
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 271:
+	case 285:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1517
+		//line sql.y:1589
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 272:
+	case 286:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1523
+		//line sql.y:1595
 		{
 			yyVAL.str = EqualStr
 		}
-	case 273:
+	case 287:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1527
+		//line sql.y:1599
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 274:
+	case 288:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1531
+		//line sql.y:1603
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 275:
+	case 289:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1535
+		//line sql.y:1607
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 276:
+	case 290:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1539
+		//line sql.y:1611
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 277:
+	case 291:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1543
+		//line sql.y:1615
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 278:
+	case 292:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1547
+		//line sql.y:1619
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 279:
+	case 293:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1552
+		//line sql.y:1624
 		{
 			yyVAL.expr = nil
 		}
-	case 280:
+	case 294:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1556
+		//line sql.y:1628
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 281:
+	case 295:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1562
+		//line sql.y:1634
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 282:
+	case 296:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1566
+		//line sql.y:1638
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 283:
+	case 297:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1570
+		//line sql.y:1642
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 284:
+	case 298:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1576
+		//line sql.y:1648
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 285:
+	case 299:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1582
+		//line sql.y:1654
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 286:
+	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1586
+		//line sql.y:1658
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 287:
+	case 301:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1592
+		//line sql.y:1664
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 288:
+	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1596
+		//line sql.y:1668
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 289:
+	case 303:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1600
+		//line sql.y:1672
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 290:
+	case 304:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1604
+		//line sql.y:1676
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 291:
+	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1608
+		//line sql.y:1680
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 292:
+	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1612
+		//line sql.y:1684
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
-	case 293:
+	case 307:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1616
+		//line sql.y:1688
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
-	case 294:
+	case 308:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1620
+		//line sql.y:1692
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
-	case 295:
+	case 309:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1624
+		//line sql.y:1696
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
-	case 296:
+	case 310:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1628
+		//line sql.y:1700
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
-	case 297:
+	case 311:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1632
+		//line sql.y:1704
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
-	case 298:
+	case 312:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1636
+		//line sql.y:1708
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
-	case 299:
+	case 313:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1640
+		//line sql.y:1712
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
-	case 300:
+	case 314:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1644
+		//line sql.y:1716
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 301:
+	case 315:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1648
+		//line sql.y:1720
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 302:
+	case 316:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1652
+		//line sql.y:1724
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
-	case 303:
+	case 317:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1656
+		//line sql.y:1728
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
-	case 304:
+	case 318:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1660
+		//line sql.y:1732
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
-	case 305:
+	case 319:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1664
+		//line sql.y:1736
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
-	case 306:
+	case 320:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1668
+		//line sql.y:1740
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 307:
+	case 321:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1672
+		//line sql.y:1744
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 308:
+	case 322:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1676
+		//line sql.y:1748
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 309:
+	case 323:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1680
+		//line sql.y:1752
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -3934,9 +4079,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 310:
+	case 324:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1688
+		//line sql.y:1760
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -3950,21 +4095,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 311:
+	case 325:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1702
+		//line sql.y:1774
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
-	case 312:
+	case 326:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1706
+		//line sql.y:1778
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
-	case 313:
+	case 327:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1710
+		//line sql.y:1782
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -3972,395 +4117,395 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent}
 		}
-	case 318:
+	case 332:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1728
+		//line sql.y:1800
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
 		}
-	case 319:
+	case 333:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1732
+		//line sql.y:1804
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 320:
+	case 334:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1736
+		//line sql.y:1808
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 321:
+	case 335:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1746
+		//line sql.y:1818
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 322:
+	case 336:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1750
+		//line sql.y:1822
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 323:
+	case 337:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1754
+		//line sql.y:1826
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 324:
+	case 338:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1758
+		//line sql.y:1830
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 325:
+	case 339:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:1762
+		//line sql.y:1834
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 326:
+	case 340:
 		yyDollar = yyS[yypt-9 : yypt+1]
-		//line sql.y:1766
+		//line sql.y:1838
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
-	case 327:
+	case 341:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1770
+		//line sql.y:1842
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str}
 		}
-	case 328:
+	case 342:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1774
+		//line sql.y:1846
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 329:
+	case 343:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1778
+		//line sql.y:1850
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colIdent}
 		}
-	case 330:
+	case 344:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1788
+		//line sql.y:1860
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 331:
+	case 345:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1792
+		//line sql.y:1864
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 332:
+	case 346:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1796
+		//line sql.y:1868
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 333:
+	case 347:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1800
+		//line sql.y:1872
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 334:
+	case 348:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1805
+		//line sql.y:1877
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 335:
+	case 349:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1810
+		//line sql.y:1882
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 336:
+	case 350:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1815
+		//line sql.y:1887
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 337:
+	case 351:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1820
+		//line sql.y:1892
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 340:
+	case 354:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1834
+		//line sql.y:1906
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 341:
+	case 355:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1838
+		//line sql.y:1910
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 342:
+	case 356:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1842
+		//line sql.y:1914
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 343:
+	case 357:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1846
+		//line sql.y:1918
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 344:
+	case 358:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1852
+		//line sql.y:1924
 		{
 			yyVAL.str = ""
 		}
-	case 345:
+	case 359:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1856
+		//line sql.y:1928
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 346:
+	case 360:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1860
+		//line sql.y:1932
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 347:
+	case 361:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line sql.y:1864
+		//line sql.y:1936
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 348:
+	case 362:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1868
+		//line sql.y:1940
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 349:
+	case 363:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1874
+		//line sql.y:1946
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 350:
+	case 364:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1878
+		//line sql.y:1950
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 351:
+	case 365:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1884
+		//line sql.y:1956
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 352:
+	case 366:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1888
+		//line sql.y:1960
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
 		}
-	case 353:
+	case 367:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1892
+		//line sql.y:1964
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal, Charset: string(yyDollar[3].bytes)}
 		}
-	case 354:
+	case 368:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1896
+		//line sql.y:1968
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 355:
+	case 369:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1900
+		//line sql.y:1972
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 356:
+	case 370:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1904
+		//line sql.y:1976
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 357:
+	case 371:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1910
+		//line sql.y:1982
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 358:
+	case 372:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1914
+		//line sql.y:1986
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 359:
+	case 373:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1918
+		//line sql.y:1990
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 360:
+	case 374:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1922
+		//line sql.y:1994
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 361:
+	case 375:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1926
+		//line sql.y:1998
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].optVal}
 		}
-	case 362:
+	case 376:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1930
+		//line sql.y:2002
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 363:
+	case 377:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1934
+		//line sql.y:2006
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 364:
+	case 378:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1939
+		//line sql.y:2011
 		{
 			yyVAL.expr = nil
 		}
-	case 365:
+	case 379:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1943
+		//line sql.y:2015
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 366:
+	case 380:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1948
+		//line sql.y:2020
 		{
 			yyVAL.str = string("")
 		}
-	case 367:
+	case 381:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1952
+		//line sql.y:2024
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 368:
+	case 382:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1958
+		//line sql.y:2030
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 369:
+	case 383:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1962
+		//line sql.y:2034
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 370:
+	case 384:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:1968
+		//line sql.y:2040
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 371:
+	case 385:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:1973
+		//line sql.y:2045
 		{
 			yyVAL.expr = nil
 		}
-	case 372:
+	case 386:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:1977
+		//line sql.y:2049
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 373:
+	case 387:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1983
+		//line sql.y:2055
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 374:
+	case 388:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:1987
+		//line sql.y:2059
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 375:
+	case 389:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:1991
+		//line sql.y:2063
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 376:
+	case 390:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:1997
+		//line sql.y:2069
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 377:
+	case 391:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2001
+		//line sql.y:2073
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
 		}
-	case 378:
+	case 392:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2005
+		//line sql.y:2077
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
 		}
-	case 379:
+	case 393:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2009
+		//line sql.y:2081
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 380:
+	case 394:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2013
+		//line sql.y:2085
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
 		}
-	case 381:
+	case 395:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2017
+		//line sql.y:2089
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
 		}
-	case 382:
+	case 396:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2021
+		//line sql.y:2093
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 383:
+	case 397:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2025
+		//line sql.y:2097
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 384:
+	case 398:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2031
+		//line sql.y:2103
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -4369,239 +4514,239 @@ yydefault:
 			}
 			yyVAL.expr = NewIntVal([]byte("1"))
 		}
-	case 385:
+	case 399:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2040
+		//line sql.y:2112
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 386:
+	case 400:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2044
+		//line sql.y:2116
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 387:
+	case 401:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2049
+		//line sql.y:2121
 		{
 			yyVAL.exprs = nil
 		}
-	case 388:
+	case 402:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2053
+		//line sql.y:2125
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 389:
+	case 403:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2058
+		//line sql.y:2130
 		{
 			yyVAL.expr = nil
 		}
-	case 390:
+	case 404:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2062
+		//line sql.y:2134
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 391:
+	case 405:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2067
+		//line sql.y:2139
 		{
 			yyVAL.orderBy = nil
 		}
-	case 392:
+	case 406:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2071
+		//line sql.y:2143
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 393:
+	case 407:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2077
+		//line sql.y:2149
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 394:
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2081
+		//line sql.y:2153
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 395:
+	case 409:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2087
+		//line sql.y:2159
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 396:
+	case 410:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2092
+		//line sql.y:2164
 		{
 			yyVAL.str = AscScr
 		}
-	case 397:
+	case 411:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2096
+		//line sql.y:2168
 		{
 			yyVAL.str = AscScr
 		}
-	case 398:
+	case 412:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2100
+		//line sql.y:2172
 		{
 			yyVAL.str = DescScr
 		}
-	case 399:
+	case 413:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2105
+		//line sql.y:2177
 		{
 			yyVAL.limit = nil
 		}
-	case 400:
+	case 414:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2109
+		//line sql.y:2181
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 401:
+	case 415:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2113
+		//line sql.y:2185
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 402:
+	case 416:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2117
+		//line sql.y:2189
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 403:
+	case 417:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2122
+		//line sql.y:2194
 		{
 			yyVAL.str = ""
 		}
-	case 404:
+	case 418:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2126
+		//line sql.y:2198
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 405:
+	case 419:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2130
+		//line sql.y:2202
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 406:
+	case 420:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2143
+		//line sql.y:2215
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 407:
+	case 421:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2147
+		//line sql.y:2219
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 408:
+	case 422:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2151
+		//line sql.y:2223
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
 		}
-	case 409:
+	case 423:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2156
+		//line sql.y:2228
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 410:
+	case 424:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line sql.y:2160
+		//line sql.y:2232
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 411:
+	case 425:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line sql.y:2164
+		//line sql.y:2236
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
 		}
-	case 412:
+	case 426:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2171
+		//line sql.y:2243
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 413:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2175
+		//line sql.y:2247
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 414:
+	case 428:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2179
+		//line sql.y:2251
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 415:
+	case 429:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2183
+		//line sql.y:2255
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 416:
+	case 430:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2188
+		//line sql.y:2260
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 417:
+	case 431:
 		yyDollar = yyS[yypt-5 : yypt+1]
-		//line sql.y:2192
+		//line sql.y:2264
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 418:
+	case 432:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2198
+		//line sql.y:2270
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 419:
+	case 433:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2202
+		//line sql.y:2274
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 420:
+	case 434:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2208
+		//line sql.y:2280
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 421:
+	case 435:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2212
+		//line sql.y:2284
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 422:
+	case 436:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2218
+		//line sql.y:2290
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 423:
+	case 437:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2224
+		//line sql.y:2296
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
@@ -4609,258 +4754,258 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 424:
+	case 438:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2234
+		//line sql.y:2306
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 425:
+	case 439:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2238
+		//line sql.y:2310
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 426:
+	case 440:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2244
+		//line sql.y:2316
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 429:
+	case 443:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2253
+		//line sql.y:2325
 		{
 			yyVAL.byt = 0
 		}
-	case 430:
+	case 444:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2255
+		//line sql.y:2327
 		{
 			yyVAL.byt = 1
 		}
-	case 431:
+	case 445:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2258
+		//line sql.y:2330
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 432:
+	case 446:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line sql.y:2260
+		//line sql.y:2332
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 433:
+	case 447:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2263
+		//line sql.y:2335
 		{
 			yyVAL.str = ""
 		}
-	case 434:
+	case 448:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2265
+		//line sql.y:2337
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 435:
+	case 449:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2269
+		//line sql.y:2341
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 436:
+	case 450:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2271
+		//line sql.y:2343
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 437:
+	case 451:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2273
+		//line sql.y:2345
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 438:
+	case 452:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2275
+		//line sql.y:2347
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 439:
+	case 453:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2277
+		//line sql.y:2349
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 440:
+	case 454:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2279
+		//line sql.y:2351
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 441:
+	case 455:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2281
+		//line sql.y:2353
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 442:
+	case 456:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2283
+		//line sql.y:2355
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 443:
+	case 457:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2285
+		//line sql.y:2357
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 444:
+	case 458:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2287
+		//line sql.y:2359
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 445:
+	case 459:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2289
+		//line sql.y:2361
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 446:
+	case 460:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2292
+		//line sql.y:2364
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 447:
+	case 461:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2294
+		//line sql.y:2366
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 448:
+	case 462:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2296
+		//line sql.y:2368
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 449:
+	case 463:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2300
+		//line sql.y:2372
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 450:
+	case 464:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2302
+		//line sql.y:2374
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 451:
+	case 465:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2305
+		//line sql.y:2377
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 452:
+	case 466:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2307
+		//line sql.y:2379
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 453:
+	case 467:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2309
+		//line sql.y:2381
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 454:
+	case 468:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2312
+		//line sql.y:2384
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 455:
+	case 469:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line sql.y:2314
+		//line sql.y:2386
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 456:
+	case 470:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2318
+		//line sql.y:2390
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 457:
+	case 471:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2322
+		//line sql.y:2394
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 459:
+	case 473:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2329
+		//line sql.y:2401
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 460:
+	case 474:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2335
+		//line sql.y:2407
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 461:
+	case 475:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2339
+		//line sql.y:2411
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 463:
+	case 477:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2346
+		//line sql.y:2418
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 613:
+	case 634:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2521
+		//line sql.y:2600
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 614:
+	case 635:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2530
+		//line sql.y:2609
 		{
 			decNesting(yylex)
 		}
-	case 615:
+	case 636:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2535
+		//line sql.y:2614
 		{
 			forceEOF(yylex)
 		}
-	case 616:
+	case 637:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line sql.y:2540
+		//line sql.y:2619
 		{
 			forceEOF(yylex)
 		}
-	case 617:
+	case 638:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2544
+		//line sql.y:2623
 		{
 			forceEOF(yylex)
 		}
-	case 618:
+	case 639:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line sql.y:2548
+		//line sql.y:2627
 		{
 			forceEOF(yylex)
 		}
@@ -96,6 +96,7 @@ func forceEOF(yylex interface{}) {
   LengthScaleOption LengthScaleOption
   columnDefinition *ColumnDefinition
   indexDefinition *IndexDefinition
+  foreignKeyDefinition *ForeignKeyDefinition
   indexInfo     *IndexInfo
   indexColumn   *IndexColumn
   indexColumns  []*IndexColumn
@@ -148,6 +149,8 @@ func forceEOF(yylex interface{}) {
 %token <bytes> TABLE INDEX VIEW TO IGNORE IF UNIQUE USING PRIMARY
 %token <bytes> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
 %token <bytes> MAXVALUE PARTITION REORGANIZE LESS THAN
+%token <bytes> GENERATED ALWAYS STORED VIRTUAL
+%token <bytes> CONSTRAINT FOREIGN REFERENCES
 
 // Type Tokens
 %token <bytes> BIT TINYINT SMALLINT MEDIUMINT INT INTEGER BIGINT INTNUM
@@ -245,15 +248,20 @@ func forceEOF(yylex interface{}) {
 %type <str> show_statement_type
 %type <columnType> column_type
 %type <columnType> int_type decimal_type numeric_type time_type char_type
+%type <columnType> generated_opt
+%type <empty> generated_always_opt
 %type <optVal> length_opt column_default_opt column_comment_opt on_update_opt
 %type <str> charset_opt collate_opt
 %type <boolVal> unsigned_opt zero_fill_opt
 %type <LengthScaleOption> float_length_opt decimal_length_opt
-%type <boolVal> null_opt auto_increment_opt
+%type <boolVal> null_opt auto_increment_opt stored_opt
 %type <colKeyOpt> column_key_opt
 %type <strs> enum_values
 %type <columnDefinition> column_definition
 %type <indexDefinition> index_definition
+%type <foreignKeyDefinition> foreign_key_definition
+%type <colIdent> constraint_name_opt
+%type <columns> foreign_key_column_list
 %type <str> index_or_key
 %type <TableSpec> table_spec table_column_list
 %type <str> table_option_list table_option table_opt_value
@@ -479,16 +487,22 @@ table_column_list:
   {
     $$.AddIndex($3)
   }
+| table_column_list ',' foreign_key_definition
+  {
+    $$.AddForeignKey($3)
+  }
 
 column_definition:
-  ID column_type null_opt column_default_opt on_update_opt auto_increment_opt column_key_opt column_comment_opt
-  {
-    $2.NotNull = $3
-    $2.Default = $4
-    $2.OnUpdate = $5
-    $2.Autoincrement = $6
-    $2.KeyOpt = $7
-    $2.Comment = $8
+  ID column_type generated_opt null_opt column_default_opt on_update_opt auto_increment_opt column_key_opt column_comment_opt
+  {
+    $2.GeneratedExpr = $3.GeneratedExpr
+    $2.Stored = $3.Stored
+    $2.NotNull = $4
+    $2.Default = $5
+    $2.OnUpdate = $6
+    $2.Autoincrement = $7
+    $2.KeyOpt = $8
+    $2.Comment = $9
     $$ = &ColumnDefinition{Name: NewColIdent(string($1)), Type: $2}
   }
 column_type:
@@ -653,6 +667,10 @@ char_type:
   {
     $$ = ColumnType{Type: string($1), EnumValues: $3}
   }
+| SET '(' enum_values ')'
+  {
+    $$ = ColumnType{Type: string($1), EnumValues: $3}
+  }
 
 enum_values:
   STRING
@@ -722,6 +740,35 @@ zero_fill_opt:
     $$ = BoolVal(true)
   }
 
+// Generated opt only fills in the generated column fields of the type
+generated_opt:
+  {
+    $$ = ColumnType{}
+  }
+| generated_always_opt AS openb expression closeb stored_opt
+  {
+    $$ = ColumnType{GeneratedExpr: $4, Stored: $6}
+  }
+
+generated_always_opt:
+  { $$ = struct{}{} }
+| GENERATED ALWAYS
+  { $$ = struct{}{} }
+
+// Stored opt returns false to mean VIRTUAL (i.e. the default) and true for STORED
+stored_opt:
+  {
+    $$ = BoolVal(false)
+  }
+| VIRTUAL
+  {
+    $$ = BoolVal(false)
+  }
+| STORED
+  {
+    $$ = BoolVal(true)
+  }
+
 // Null opt returns false to mean NULL (i.e. the default) and true for NOT NULL
 null_opt:
   {
@@ -837,6 +884,31 @@ index_definition:
     $$ = &IndexDefinition{Info: $1, Columns: $3}
   }
 
+foreign_key_definition:
+  constraint_name_opt FOREIGN KEY '(' foreign_key_column_list ')' REFERENCES table_name '(' foreign_key_column_list ')'
+  {
+    $$ = &ForeignKeyDefinition{Name: $1, Source: $5, ReferencedTable: $8, ReferencedColumns: $10}
+  }
+
+constraint_name_opt:
+  {
+    $$ = ColIdent{}
+  }
+| CONSTRAINT ID
+  {
+    $$ = NewColIdent(string($2))
+  }
+
+foreign_key_column_list:
+  sql_id
+  {
+    $$ = Columns{$1}
+  }
+| foreign_key_column_list ',' sql_id
+  {
+    $$ = append($$, $3)
+  }
+
 index_info:
   PRIMARY KEY
   {
@@ -2455,6 +2527,7 @@ reserved_keyword:
 */
 non_reserved_keyword:
   AGAINST
+| ALWAYS
 | BIGINT
 | BIT
 | BLOB
@@ -2463,6 +2536,7 @@ non_reserved_keyword:
 | CHARACTER
 | CHARSET
 | COMMENT_KEYWORD
+| CONSTRAINT
 | DATE
 | DATETIME
 | DECIMAL
@@ -2471,6 +2545,8 @@ non_reserved_keyword:
 | ENUM
 | EXPANSION
 | FLOAT_TYPE
+| FOREIGN
+| GENERATED
 | INT
 | INTEGER
 | JSON
@@ -2492,11 +2568,13 @@ non_reserved_keyword:
 | PRIMARY
 | QUERY
 | REAL
+| REFERENCES
 | REORGANIZE
 | REPAIR
 | SHARE
 | SIGNED
 | SMALLINT
+| STORED
 | TEXT
 | THAN
 | TIME
@@ -2509,6 +2587,7 @@ non_reserved_keyword:
 | VARBINARY
 | VARCHAR
 | VIEW
+| VIRTUAL
 | VITESS_KEYSPACES
 | VITESS_SHARDS
 | VSCHEMA_TABLES
@@ -87,6 +87,7 @@ var keywords = map[string]int{
 	"against":             AGAINST,
 	"all":                 ALL,
 	"alter":               ALTER,
+	"always":              ALWAYS,
 	"analyze":             ANALYZE,
 	"and":                 AND,
 	"as":                  AS,
@@ -117,7 +118,7 @@ var keywords = map[string]int{
 	"column":              UNUSED,
 	"comment":             COMMENT_KEYWORD,
 	"condition":           UNUSED,
-	"constraint":          UNUSED,
+	"constraint":          CONSTRAINT,
 	"continue":            UNUSED,
 	"convert":             CONVERT,
 	"create":              CREATE,
@@ -169,10 +170,10 @@ var keywords = map[string]int{
 	"float8":              UNUSED,
 	"for":                 FOR,
 	"force":               FORCE,
-	"foreign":             UNUSED,
+	"foreign":             FOREIGN,
 	"from":                FROM,
 	"fulltext":            UNUSED,
-	"generated":           UNUSED,
+	"generated":           GENERATED,
 	"get":                 UNUSED,
 	"grant":               UNUSED,
 	"group":               GROUP,
@@ -268,7 +269,7 @@ var keywords = map[string]int{
 	"reads":               UNUSED,
 	"read_write":          UNUSED,
 	"real":                REAL,
-	"references":          UNUSED,
+	"references":          REFERENCES,
 	"regexp":              REGEXP,
 	"release":             UNUSED,
 	"rename":              RENAME,
@@ -308,7 +309,7 @@ var keywords = map[string]int{
 	"sql_small_result":    UNUSED,
 	"ssl":                 UNUSED,
 	"starting":            UNUSED,
-	"stored":              UNUSED,
+	"stored":              STORED,
 	"straight_join":       STRAIGHT_JOIN,
 	"table":               TABLE,
 	"tables":              TABLES,
@@ -343,7 +344,7 @@ var keywords = map[string]int{
 	"varchar":             VARCHAR,
 	"varcharacter":        UNUSED,
 	"varying":             UNUSED,
-	"virtual":             UNUSED,
+	"virtual":             VIRTUAL,
 	"view":                VIEW,
 	"vitess_keyspaces":    VITESS_KEYSPACES,
 	"vitess_shards":       VITESS_SHARDS,
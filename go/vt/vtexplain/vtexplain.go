@@ -23,17 +23,28 @@ import (
 	"bytes"
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
+	"golang.org/x/net/context"
+
 	log "github.com/golang/glog"
 
 	"github.com/youtube/vitess/go/jsonutil"
+	"github.com/youtube/vitess/go/sqltypes"
 	"github.com/youtube/vitess/go/sync2"
+	"github.com/youtube/vitess/go/vt/discovery"
+	"github.com/youtube/vitess/go/vt/grpcclient"
 	"github.com/youtube/vitess/go/vt/sqlparser"
+	"github.com/youtube/vitess/go/vt/topo/topoproto"
+	"github.com/youtube/vitess/go/vt/vtgate"
 	"github.com/youtube/vitess/go/vt/vtgate/engine"
 
 	querypb "github.com/youtube/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "github.com/youtube/vitess/go/vt/proto/tabletmanagerdata"
+	tabletmanagerservicepb "github.com/youtube/vitess/go/vt/proto/tabletmanagerservice"
+	vtgatepb "github.com/youtube/vitess/go/vt/proto/vtgate"
 )
 
 // Options to control the explain process
@@ -47,8 +58,156 @@ type Options struct {
 
 	// Normalize controls whether or not vtgate does query normalization
 	Normalize bool
+
+	// NumRows is the number of rows returned or affected by each simulated
+	// query on the tablets. If zero, a single row is used.
+	NumRows int
+
+	// RowsPerTable overrides NumRows for queries on specific tables
+	RowsPerTable map[string]int
+
+	// ErrorQueries maps query patterns to an error message that the
+	// simulated mysql returns for any matching query. The patterns are
+	// regular expressions that must match the whole query.
+	ErrorQueries map[string]string
+
+	// StatementDurations maps each kind of statement (select, insert,
+	// replace, update or delete) to the number of logical time units it
+	// takes on the simulated tablets. Statements take one unit by default.
+	StatementDurations map[string]int
+
+	// ShardLatencies maps shard names, e.g. "-80", to the simulated
+	// latency of the queries sent to the tablets of that shard, which
+	// start that many logical time units later than they are sent
+	ShardLatencies map[string]ShardLatency
+
+	// LatencySeed seeds the random jitter of the shard latencies, so that
+	// runs with the same seed have the same timeline
+	LatencySeed int64
+
+	// StreamBatchSize is the number of rows of each result sent back by
+	// the simulated tablets for streaming queries, the first of which also
+	// has the fields. If zero, the rows are sent as the tabletserver
+	// buffers them.
+	StreamBatchSize int
+
+	// MultiStatements controls whether the simulated mysql accepts
+	// semicolon-separated statements in a single query, in which case
+	// each statement is executed in turn and the result of the last
+	// one is returned
+	MultiStatements bool
+
+	// ColumnValues maps "table.column" to a list of values that are used
+	// in turn for that column in the rows generated by the simulated
+	// mysql, instead of synthetic values
+	ColumnValues map[string][]string
+
+	// ColumnTypeOverrides maps "table.column" to the type used for that
+	// column instead of the one derived from its definition, for types
+	// that the schema parser doesn't map correctly
+	ColumnTypeOverrides map[string]querypb.Type
+
+	// Collations maps "table" or "table.column" to the collation of the
+	// text columns of that table or of that column, instead of the one in
+	// the schema. The simulated tablets compare the values of columns
+	// with a case-insensitive collation, whose name ends in _ci, without
+	// regard to case, e.g. when counting the rows matched by a list of
+	// primary key values.
+	Collations map[string]string
+
+	// ReplaceConflict controls whether every row written by a simulated
+	// REPLACE is assumed to conflict with an existing row, in which case
+	// mysql deletes the old row before inserting the new one and reports
+	// 2 affected rows for it
+	ReplaceConflict bool
+
+	// DuplicateKeyConflict controls whether every row written by a
+	// simulated INSERT ... ON DUPLICATE KEY UPDATE is assumed to conflict
+	// with an existing row, in which case mysql updates the existing row
+	// and reports 2 affected rows for it
+	DuplicateKeyConflict bool
+
+	// RecordInsertValues controls whether the values of each row written
+	// by a simulated INSERT or REPLACE are recorded on the tablet and mysql
+	// queries, with the bind variables sent to the tablet resolved
+	RecordInsertValues bool
+
+	// ValueGenerator generates the values of the columns of the rows
+	// returned by simulated selects, except for the columns that have
+	// ColumnValues. The built-in values are used if it is nil.
+	ValueGenerator ValueGenerator
+
+	// QueryHook is called by the simulated mysql of each tablet with each
+	// statement it executes, once the tablet has started, if it is set
+	QueryHook QueryHook
+
+	// StrictNotNull controls whether simulated inserts fail, as in mysql,
+	// when they don't set a not null column that has no default and is not
+	// auto_increment
+	StrictNotNull bool
+
+	// CheckBindVarTypes controls whether the simulated tablets reject the
+	// queries that compare a column for equality with a bind variable that
+	// mysql would have to convert, e.g. a string for an integer column
+	CheckBindVarTypes bool
+
+	// SQLMode is the global sql_mode reported by the simulated mysql,
+	// STRICT_TRANS_TABLES if empty. It doesn't change how queries are
+	// parsed, e.g. ANSI_QUOTES has no effect.
+	SQLMode string
+
+	// MysqlVersion is the server version reported by the simulated mysql
+	// for @@version and version(), 5.7.20 if empty.
+	MysqlVersion string
+
+	// QueryTimeout is the timeout of the context each statement is
+	// executed with by vtgate. Zero means no timeout.
+	QueryTimeout time.Duration
+
+	// QueryLatency is the simulated time each query takes on the tablets.
+	// Queries whose context deadline is closer than that fail with a
+	// deadline exceeded error instead of being executed.
+	QueryLatency time.Duration
+
+	// UnixTimestamp is the value of unix_timestamp() on the simulated
+	// tablets, and the time used for the values generated for temporal
+	// columns. If zero, fixed defaults are used.
+	UnixTimestamp int64
+
+	// TimeZone is the session time_zone of the simulated mysql, either an
+	// offset like +05:30 or a named zone like Europe/Paris. The values
+	// generated for temporal columns are in that zone. If empty, the
+	// SYSTEM zone of the simulated mysql, UTC, is used.
+	TimeZone string
+}
+
+// ShardLatency is the simulated latency of the queries sent to a shard, in
+// logical time units. Each query is delayed by Base units plus a random
+// number of units between 0 and Jitter.
+type ShardLatency struct {
+	Base   int
+	Jitter int
+}
+
+// ValueGenerator generates the values of the rows returned by the simulated
+// tablets, e.g. to keep the values of related columns of different tables
+// consistent.
+type ValueGenerator interface {
+	// Generate returns the value of the given column of type typ in the
+	// row with the given index, starting from 0. The table is the schema
+	// table of the column, or empty for an expression or a column of a
+	// derived table, in which case the column is the text of the
+	// expression or the name of the derived column.
+	Generate(table, column string, typ querypb.Type, rowIndex int) (sqltypes.Value, error)
 }
 
+// QueryHook is called with a statement that the simulated mysql executed,
+// the statement parsed, or nil if the parser doesn't support it, and its
+// simulated result, e.g. to collect or check the queries sent to mysql. If
+// it returns an error, the statement fails with that error instead. It may
+// be called concurrently by the tablets of different shards.
+type QueryHook func(query string, stmt sqlparser.Statement, result *sqltypes.Result) error
+
 // TabletQuery defines a query that was sent to a given tablet and how it was
 // processed in mysql
 type TabletQuery struct {
@@ -60,6 +219,32 @@ type TabletQuery struct {
 
 	// BindVars sent with the command
 	BindVars map[string]*querypb.BindVariable
+
+	// ListSizes is the number of values of each list bind variable, for
+	// instance the values of an IN clause routed to the tablet
+	ListSizes map[string]int
+
+	// Lock is the locking clause of a select, either "for update" or
+	// "lock in share mode", or empty for a non-locking read
+	Lock string
+
+	// Distinct is set for a select distinct
+	Distinct bool
+
+	// TransactionID is the id of the tabletserver transaction the query
+	// was executed in, or zero for queries executed outside of one
+	TransactionID int64
+
+	// Batches is the number of results sent back for a streaming query
+	Batches int
+
+	// InsertValues are the values of each row written by an insert or
+	// replace, keyed by column, if Options.RecordInsertValues is set
+	InsertValues []map[string]string
+
+	// BindVarTypes are the types of the columns that the query compares
+	// with its bind variables
+	BindVarTypes BindVarTypes
 }
 
 // MysqlQuery defines a query that was sent to a given tablet and how it was
@@ -70,6 +255,27 @@ type MysqlQuery struct {
 
 	// SQL command sent to the given tablet
 	SQL string
+
+	// InsertValues are the values of each row written by an insert or
+	// replace, keyed by column, if Options.RecordInsertValues is set
+	InsertValues []map[string]string `json:",omitempty"`
+
+	// BindVarTypes are the types of the columns that the query compares
+	// with any bind variables left in it
+	BindVarTypes BindVarTypes `json:",omitempty"`
+}
+
+// BindVarTypes maps the names of bind variables that a query compares for
+// equality with a column, e.g. v1 in "id = :v1", to the type of the column.
+type BindVarTypes map[string]querypb.Type
+
+// MarshalJSON renders the types by name
+func (types BindVarTypes) MarshalJSON() ([]byte, error) {
+	names := make(map[string]string, len(types))
+	for name, typ := range types {
+		names[name] = typ.String()
+	}
+	return jsonutil.MarshalNoEscape(names)
 }
 
 // MarshalJSON renders the json structure
@@ -83,13 +289,21 @@ func (tq *TabletQuery) MarshalJSON() ([]byte, error) {
 	}
 
 	return jsonutil.MarshalNoEscape(&struct {
-		Time     int
-		SQL      string
-		BindVars map[string]string
+		Time         int
+		SQL          string
+		BindVars     map[string]string
+		Lock         string              `json:",omitempty"`
+		Distinct     bool                `json:",omitempty"`
+		InsertValues []map[string]string `json:",omitempty"`
+		BindVarTypes BindVarTypes        `json:",omitempty"`
 	}{
-		Time:     tq.Time,
-		SQL:      tq.SQL,
-		BindVars: bindVars,
+		Time:         tq.Time,
+		SQL:          tq.SQL,
+		BindVars:     bindVars,
+		Lock:         tq.Lock,
+		Distinct:     tq.Distinct,
+		InsertValues: tq.InsertValues,
+		BindVarTypes: tq.BindVarTypes,
 	})
 }
 
@@ -119,31 +333,141 @@ const (
 	vtexplainCell = "explainCell"
 )
 
-// Init sets up the fake execution environment
-func Init(vSchemaStr, sqlSchema string, opts *Options) error {
+// VTExplain is a simulated vtgate and set of vttablets for a given vschema
+// and schema. Each VTExplain has its own state, so several of them can be
+// used in the same process, but a single VTExplain must not be used to run
+// queries concurrently.
+type VTExplain struct {
+	env            *tabletEnv
+	keyspaceEnvs   map[string]*tabletEnv
+	explainTopo    *ExplainTopo
+	vtgateExecutor *vtgate.Executor
+	healthCheck    *discovery.FakeHealthCheck
+	vtgateSession  *vtgatepb.Session
+	queryTimeout   time.Duration
+}
+
+// defaultVTExplain is the environment used by Init and Run
+var defaultVTExplain *VTExplain
+
+// New sets up a fake execution environment for the given vschema and
+// schema. Stop should be called once it is no longer needed.
+func New(vSchemaStr, sqlSchema string, opts *Options) (*VTExplain, error) {
 	// Verify options
 	if opts.ReplicationMode != "ROW" && opts.ReplicationMode != "STATEMENT" {
-		return fmt.Errorf("invalid replication mode \"%s\"", opts.ReplicationMode)
+		return nil, fmt.Errorf("invalid replication mode \"%s\"", opts.ReplicationMode)
+	}
+	if opts.NumRows < 0 {
+		return nil, fmt.Errorf("invalid number of rows %d", opts.NumRows)
 	}
 
 	parsedDDLs, err := parseSchema(sqlSchema)
 	if err != nil {
-		return fmt.Errorf("parseSchema: %v", err)
+		return nil, fmt.Errorf("parseSchema: %v", err)
 	}
 
-	err = initTabletEnvironment(parsedDDLs, opts)
+	vte := &VTExplain{queryTimeout: opts.QueryTimeout}
+	vte.env, vte.keyspaceEnvs, err = newKeyspaceEnvironments(parsedDDLs, opts)
 	if err != nil {
-		return fmt.Errorf("initTabletEnvironment: %v", err)
+		return nil, fmt.Errorf("newTabletEnvironment: %v", err)
 	}
 
-	err = initVtgateExecutor(vSchemaStr, opts)
+	err = vte.initVtgateExecutor(vSchemaStr, opts)
 	if err != nil {
-		return fmt.Errorf("initVtgateExecutor: %v", err)
+		vte.Stop()
+		return nil, fmt.Errorf("initVtgateExecutor: %v", err)
+	}
+
+	return vte, nil
+}
+
+// keyspaceEnv returns the environment of the tablets of the given keyspace.
+func (vte *VTExplain) keyspaceEnv(keyspace string) *tabletEnv {
+	if env, ok := vte.keyspaceEnvs[keyspace]; ok {
+		return env
+	}
+	return vte.env
+}
+
+// databaseEnv returns the environment of the given keyspace, or false if it
+// isn't in the vschema.
+func (vte *VTExplain) databaseEnv(keyspace string) (*tabletEnv, bool) {
+	if _, ok := vte.explainTopo.Keyspaces[keyspace]; !ok {
+		return nil, false
+	}
+	return vte.keyspaceEnv(keyspace), true
+}
+
+// tabletEnv returns the environment of the named tablet.
+func (vte *VTExplain) tabletEnv(tablet string) *tabletEnv {
+	if tc, ok := vte.explainTopo.TabletConns[tablet]; ok {
+		return tc.env
 	}
+	return vte.env
+}
+
+// Stop shuts down the simulated tablets.
+func (vte *VTExplain) Stop() {
+	if vte.explainTopo == nil {
+		return
+	}
+	for _, tablet := range vte.explainTopo.TabletConns {
+		tablet.tsv.StopService()
+		tablet.db.Close()
+	}
+}
 
+// Init sets up the fake execution environment used by Run, replacing the
+// one from any previous call.
+func Init(vSchemaStr, sqlSchema string, opts *Options) error {
+	vte, err := New(vSchemaStr, sqlSchema, opts)
+	if err != nil {
+		return err
+	}
+	if defaultVTExplain != nil {
+		defaultVTExplain.Stop()
+	}
+	defaultVTExplain = vte
 	return nil
 }
 
+// LoadTabletSchema fetches the schema from the running vttablet at the given
+// grpc address and returns the create table statements for all of its
+// tables, in the form expected by Init. The cert, key, ca and name
+// parameters configure TLS for the connection as in
+// grpcclient.SecureDialOption. Each table must be a create table
+// statement that parses, so that none is silently left out by Init.
+func LoadTabletSchema(ctx context.Context, addr, cert, key, ca, name string) (string, error) {
+	opt, err := grpcclient.SecureDialOption(cert, key, ca, name)
+	if err != nil {
+		return "", err
+	}
+	cc, err := grpcclient.DialContext(ctx, addr, true, opt)
+	if err != nil {
+		return "", err
+	}
+	defer cc.Close()
+
+	response, err := tabletmanagerservicepb.NewTabletManagerClient(cc).GetSchema(ctx, &tabletmanagerdatapb.GetSchemaRequest{})
+	if err != nil {
+		return "", fmt.Errorf("GetSchema(%s): %v", addr, err)
+	}
+
+	var b bytes.Buffer
+	for _, td := range response.SchemaDefinition.GetTableDefinitions() {
+		// The schema of each table is the output of show create table
+		stmt, err := sqlparser.ParseStrictDDL(td.Schema)
+		if err != nil {
+			return "", fmt.Errorf("cannot parse the schema of table %s: %v", td.Name, err)
+		}
+		if ddl, ok := stmt.(*sqlparser.DDL); !ok || ddl.Action != sqlparser.CreateStr || ddl.TableSpec == nil {
+			return "", fmt.Errorf("the schema of table %s is not a create table statement: %s", td.Name, td.Schema)
+		}
+		fmt.Fprintf(&b, "%s;\n", td.Schema)
+	}
+	return b.String(), nil
+}
+
 func parseSchema(sqlSchema string) ([]*sqlparser.DDL, error) {
 	parsedDDLs := make([]*sqlparser.DDL, 0, 16)
 	for {
@@ -185,8 +509,17 @@ func parseSchema(sqlSchema string) ([]*sqlparser.DDL, error) {
 	return parsedDDLs, nil
 }
 
-// Run the explain analysis on the given queries
+// Run the explain analysis on the given queries in the environment set up
+// by Init
 func Run(sql string) ([]*Explain, error) {
+	if defaultVTExplain == nil {
+		return nil, fmt.Errorf("vtexplain is not initialized")
+	}
+	return defaultVTExplain.Run(sql)
+}
+
+// Run the explain analysis on the given queries
+func (vte *VTExplain) Run(sql string) ([]*Explain, error) {
 	explains := make([]*Explain, 0, 16)
 
 	var (
@@ -211,10 +544,15 @@ func Run(sql string) ([]*Explain, error) {
 		}
 
 		if sql != "" {
-			// Reset the global time simulator for each query
-			batchTime = sync2.NewBatcher(time.Duration(10 * time.Millisecond))
+			// Reset the time simulator for each query, which is shared
+			// by the tablets of all the keyspaces
+			batchTime := sync2.NewBatcher(time.Duration(10 * time.Millisecond))
+			vte.env.batchTime = batchTime
+			for _, env := range vte.keyspaceEnvs {
+				env.batchTime = batchTime
+			}
 			log.V(100).Infof("explain %s", sql)
-			e, err := explain(sql)
+			e, err := vte.explain(sql)
 			if err != nil {
 				return nil, err
 			}
@@ -230,8 +568,8 @@ func Run(sql string) ([]*Explain, error) {
 	return explains, nil
 }
 
-func explain(sql string) (*Explain, error) {
-	plans, tabletActions, err := vtgateExecute(sql)
+func (vte *VTExplain) explain(sql string) (*Explain, error) {
+	plans, tabletActions, err := vte.vtgateExecute(sql)
 	if err != nil {
 		return nil, err
 	}
@@ -243,6 +581,130 @@ func explain(sql string) (*Explain, error) {
 	}, nil
 }
 
+// FullScan is a select run on the simulated mysql of a tablet that is
+// likely to scan whole tables
+type FullScan struct {
+	// Tablet that ran the query
+	Tablet string
+
+	// Logical time of the query
+	Time int
+
+	// SQL of the query
+	SQL string
+
+	// Tables that are likely to be scanned
+	Tables []string
+}
+
+// FullScans returns the selects run on mysql in the given explains whose
+// conditions don't constrain the leading column of any index of some of
+// their tables, in the environment set up by Init
+func FullScans(explains []*Explain) []*FullScan {
+	if defaultVTExplain == nil {
+		return nil
+	}
+	return defaultVTExplain.FullScans(explains)
+}
+
+// FullScans returns the selects run on mysql in the given explains whose
+// conditions don't constrain the leading column of any index of some of
+// their tables. They are ordered by explain, then by tablet and time.
+func (vte *VTExplain) FullScans(explains []*Explain) []*FullScan {
+	var scans []*FullScan
+	for _, explain := range explains {
+		tablets := make([]string, 0, len(explain.TabletActions))
+		for tablet := range explain.TabletActions {
+			tablets = append(tablets, tablet)
+		}
+		sort.Strings(tablets)
+
+		for _, tablet := range tablets {
+			queries := make([]*MysqlQuery, len(explain.TabletActions[tablet].MysqlQueries))
+			copy(queries, explain.TabletActions[tablet].MysqlQueries)
+			sort.SliceStable(queries, func(i, j int) bool {
+				return queries[i].Time < queries[j].Time
+			})
+			for _, q := range queries {
+				tables := vte.tabletEnv(tablet).fullScanTables(q.SQL)
+				if len(tables) == 0 {
+					continue
+				}
+				scans = append(scans, &FullScan{
+					Tablet: tablet,
+					Time:   q.Time,
+					SQL:    q.SQL,
+					Tables: tables,
+				})
+			}
+		}
+	}
+	return scans
+}
+
+// TableAccess is the number of queries run on the simulated mysql of a
+// tablet that read or wrote a table
+type TableAccess struct {
+	// Tablet that ran the queries
+	Tablet string
+
+	// Table that was accessed
+	Table string
+
+	// Number of times the table was read, e.g. by a select or by the
+	// subqueries of a dml
+	Reads int
+
+	// Number of times the table was written by an insert, update or delete
+	Writes int
+}
+
+// TableAccesses returns how many times each table was read and written by
+// the queries run on mysql in the given explains, which helps to find the
+// hot spots of a workload. They are ordered by tablet, then by table.
+// Queries that can't be parsed are skipped.
+func TableAccesses(explains []*Explain) []*TableAccess {
+	counts := make(map[string]map[string]*TableAccess)
+	count := func(tablet, table string) *TableAccess {
+		if counts[tablet] == nil {
+			counts[tablet] = make(map[string]*TableAccess)
+		}
+		access := counts[tablet][table]
+		if access == nil {
+			access = &TableAccess{Tablet: tablet, Table: table}
+			counts[tablet][table] = access
+		}
+		return access
+	}
+	for _, explain := range explains {
+		for tablet, actions := range explain.TabletActions {
+			for _, q := range actions.MysqlQueries {
+				reads, writes := tableAccesses(q.SQL)
+				for _, table := range reads {
+					count(tablet, table).Reads++
+				}
+				for _, table := range writes {
+					count(tablet, table).Writes++
+				}
+			}
+		}
+	}
+
+	var accesses []*TableAccess
+	for _, tables := range counts {
+		for _, access := range tables {
+			accesses = append(accesses, access)
+		}
+	}
+	sort.Slice(accesses, func(i, j int) bool {
+		if accesses[i].Tablet != accesses[j].Tablet {
+			return accesses[i].Tablet < accesses[j].Tablet
+		}
+		return accesses[i].Table < accesses[j].Table
+	})
+	return accesses
+}
+
 type outputQuery struct {
 	tablet string
 	Time   int
@@ -286,8 +748,206 @@ func ExplainsAsText(explains []*Explain) string {
 	return string(b.Bytes())
 }
 
+// ExplainsAsMarkdown returns a markdown representation of the explains,
+// with a table per shard of the queries run on mysql for each statement
+// in logical time order
+func ExplainsAsMarkdown(explains []*Explain) string {
+	var b bytes.Buffer
+	for i, explain := range explains {
+		fmt.Fprintf(&b, "## Query %d\n\n", i+1)
+		fmt.Fprintf(&b, "```sql\n%s\n```\n\n", explain.SQL)
+
+		tablets := make([]string, 0, len(explain.TabletActions))
+		for tablet := range explain.TabletActions {
+			tablets = append(tablets, tablet)
+		}
+		sort.Strings(tablets)
+
+		for _, tablet := range tablets {
+			queries := make([]*MysqlQuery, len(explain.TabletActions[tablet].MysqlQueries))
+			copy(queries, explain.TabletActions[tablet].MysqlQueries)
+			sort.SliceStable(queries, func(i, j int) bool {
+				return queries[i].Time < queries[j].Time
+			})
+
+			// The tablets are named after their keyspace and shard.
+			shard := tablet
+			if _, s, err := topoproto.ParseKeyspaceShard(tablet); err == nil {
+				shard = s
+			}
+
+			fmt.Fprintf(&b, "### %s\n\n", tablet)
+			fmt.Fprintf(&b, "| Time | Shard | SQL |\n")
+			fmt.Fprintf(&b, "|------|-------|-----|\n")
+			for _, q := range queries {
+				fmt.Fprintf(&b, "| %d | %s | %s |\n", q.Time, shard, markdownEscaper.Replace(q.SQL))
+			}
+			fmt.Fprintf(&b, "\n")
+		}
+	}
+	return b.String()
+}
+
+// markdownEscaper escapes the characters that would break a markdown
+// table cell
+var markdownEscaper = strings.NewReplacer("|", "\\|", "\n", " ")
+
 // ExplainsAsJSON returns a json representation of the explains
 func ExplainsAsJSON(explains []*Explain) string {
 	explainJSON, _ := jsonutil.MarshalIndentNoEscape(explains, "", "    ")
 	return string(explainJSON)
 }
+
+// schemaOutput is the json representation of the schema of a VTExplain
+type schemaOutput struct {
+	// the tables that aren't qualified by a keyspace
+	Tables []*schemaTable
+
+	// the tables seen by the tablets of each keyspace that has qualified
+	// tables
+	Keyspaces map[string][]*schemaTable `json:",omitempty"`
+}
+
+// SchemaAsJSON returns a json representation of the tables of the schema
+// as interpreted by vtexplain, in the environment set up by Init
+func SchemaAsJSON() string {
+	if defaultVTExplain == nil {
+		return ""
+	}
+	return defaultVTExplain.SchemaAsJSON()
+}
+
+// SchemaAsJSON returns a json representation of the tables of the schema
+// as interpreted by vtexplain, with the types and nullability of their
+// columns and the columns of their indexes, which is suitable for checking
+// the interpretation of the DDL against the information_schema of mysql.
+func (vte *VTExplain) SchemaAsJSON() string {
+	output := &schemaOutput{Tables: vte.env.schemaTables()}
+	if len(vte.keyspaceEnvs) != 0 {
+		output.Keyspaces = make(map[string][]*schemaTable)
+		for keyspace, env := range vte.keyspaceEnvs {
+			output.Keyspaces[keyspace] = env.schemaTables()
+		}
+	}
+	outputJSON, _ := jsonutil.MarshalIndentNoEscape(output, "", "    ")
+	return string(outputJSON)
+}
+
+// tabletQueriesOutput is the json representation of the queries sent to
+// each tablet for a single statement
+type tabletQueriesOutput struct {
+	SQL           string
+	TabletQueries map[string][]*tabletQueryOutput
+}
+
+type tabletQueryOutput struct {
+	Time         int
+	SQL          string
+	BindVars     map[string]*bindVarOutput
+	Lock         string              `json:",omitempty"`
+	Distinct     bool                `json:",omitempty"`
+	InsertValues []map[string]string `json:",omitempty"`
+	BindVarTypes BindVarTypes        `json:",omitempty"`
+
+	// Transaction numbers the transactions of a tablet in order of
+	// appearance, as the tabletserver transaction ids change between runs
+	Transaction int `json:",omitempty"`
+}
+
+// bindVarOutput renders a bind variable with its type and its value as a
+// json string or number, or a list of values for tuples
+type bindVarOutput struct {
+	Type   string
+	Value  interface{}   `json:",omitempty"`
+	Values []interface{} `json:",omitempty"`
+	Count  int           `json:",omitempty"`
+}
+
+// TabletQueriesAsJSON returns a json representation of the queries that
+// were sent to each tablet, including their bind variables, which is
+// suitable for diffing the simulated interactions between runs.
+func TabletQueriesAsJSON(explains []*Explain) string {
+	output := make([]*tabletQueriesOutput, 0, len(explains))
+	for _, explain := range explains {
+		tq := &tabletQueriesOutput{
+			SQL:           explain.SQL,
+			TabletQueries: make(map[string][]*tabletQueryOutput),
+		}
+		for tablet, actions := range explain.TabletActions {
+			queries := make([]*tabletQueryOutput, 0, len(actions.TabletQueries))
+			transactions := make(map[int64]int)
+			for _, q := range actions.TabletQueries {
+				bindVars := make(map[string]*bindVarOutput)
+				for name, bv := range q.BindVars {
+					bindVars[name] = newBindVarOutput(bv)
+				}
+				if q.TransactionID != 0 && transactions[q.TransactionID] == 0 {
+					transactions[q.TransactionID] = len(transactions) + 1
+				}
+				queries = append(queries, &tabletQueryOutput{
+					Time:         q.Time,
+					SQL:          q.SQL,
+					BindVars:     bindVars,
+					Lock:         q.Lock,
+					Distinct:     q.Distinct,
+					InsertValues: q.InsertValues,
+					BindVarTypes: q.BindVarTypes,
+					Transaction:  transactions[q.TransactionID],
+				})
+			}
+			tq.TabletQueries[tablet] = queries
+		}
+		output = append(output, tq)
+	}
+	outputJSON, _ := jsonutil.MarshalIndentNoEscape(output, "", "    ")
+	return string(outputJSON)
+}
+
+func newBindVarOutput(bv *querypb.BindVariable) *bindVarOutput {
+	out := &bindVarOutput{Type: bv.Type.String()}
+	if bv.Type == querypb.Type_TUPLE {
+		out.Values = make([]interface{}, 0, len(bv.Values))
+		for _, val := range bv.Values {
+			out.Values = append(out.Values, bindVarValue(val.Type, val.Value))
+		}
+		out.Count = len(bv.Values)
+		return out
+	}
+	out.Value = bindVarValue(bv.Type, bv.Value)
+	return out
+}
+
+// listSizes returns the number of values of the list bind variables.
+func listSizes(bindVars map[string]*querypb.BindVariable) map[string]int {
+	var sizes map[string]int
+	for name, bv := range bindVars {
+		if bv.Type != querypb.Type_TUPLE {
+			continue
+		}
+		if sizes == nil {
+			sizes = make(map[string]int)
+		}
+		sizes[name] = len(bv.Values)
+	}
+	return sizes
+}
+
+// bindVarValue returns numeric values as json numbers and everything else
+// as a string
+func bindVarValue(typ querypb.Type, val []byte) interface{} {
+	switch {
+	case sqltypes.IsSigned(typ):
+		if v, err := strconv.ParseInt(string(val), 10, 64); err == nil {
+			return v
+		}
+	case sqltypes.IsUnsigned(typ):
+		if v, err := strconv.ParseUint(string(val), 10, 64); err == nil {
+			return v
+		}
+	case sqltypes.IsFloat(typ):
+		if v, err := strconv.ParseFloat(string(val), 64); err == nil {
+			return v
+		}
+	}
+	return string(val)
+}
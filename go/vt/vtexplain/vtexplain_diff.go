@@ -0,0 +1,165 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtexplain
+
+import (
+	"sort"
+
+	"github.com/youtube/vitess/go/vt/sqlparser"
+
+	querypb "github.com/youtube/vitess/go/vt/proto/query"
+)
+
+// TabletQueriesDiff is the difference between the queries sent to a
+// tablet in two runs, after normalization
+type TabletQueriesDiff struct {
+	Tablet string
+
+	// Added are the queries that were only sent in the second run
+	Added []string
+
+	// Removed are the queries that were only sent in the first run
+	Removed []string
+
+	// Reordered is set if the queries sent in both runs were not sent
+	// in the same order
+	Reordered bool
+}
+
+// DiffTabletQueries compares the queries sent to each tablet in two runs,
+// for instance with different schemas or vschemas, and returns the
+// differences sorted by tablet. Literals and bind variable names are
+// normalized, so only structural changes of the queries are reported.
+func DiffTabletQueries(before, after map[string]*TabletActions) []*TabletQueriesDiff {
+	tablets := make(map[string]bool)
+	for tablet := range before {
+		tablets[tablet] = true
+	}
+	for tablet := range after {
+		tablets[tablet] = true
+	}
+	names := make([]string, 0, len(tablets))
+	for tablet := range tablets {
+		names = append(names, tablet)
+	}
+	sort.Strings(names)
+
+	var diffs []*TabletQueriesDiff
+	for _, tablet := range names {
+		diff := diffQueries(normalizedQueries(before[tablet]), normalizedQueries(after[tablet]))
+		if diff == nil {
+			continue
+		}
+		diff.Tablet = tablet
+		diffs = append(diffs, diff)
+	}
+	return diffs
+}
+
+// diffQueries returns the difference between two lists of queries, or
+// nil if they are the same
+func diffQueries(before, after []string) *TabletQueriesDiff {
+	diff := &TabletQueriesDiff{}
+
+	// Count the queries of each run to find the ones that are only in
+	// one of them, and keep the common ones in order.
+	counts := make(map[string]int)
+	for _, q := range after {
+		counts[q]++
+	}
+	var beforeCommon []string
+	for _, q := range before {
+		if counts[q] == 0 {
+			diff.Removed = append(diff.Removed, q)
+			continue
+		}
+		counts[q]--
+		beforeCommon = append(beforeCommon, q)
+	}
+
+	counts = make(map[string]int)
+	for _, q := range before {
+		counts[q]++
+	}
+	var afterCommon []string
+	for _, q := range after {
+		if counts[q] == 0 {
+			diff.Added = append(diff.Added, q)
+			continue
+		}
+		counts[q]--
+		afterCommon = append(afterCommon, q)
+	}
+
+	for i := range beforeCommon {
+		if beforeCommon[i] != afterCommon[i] {
+			diff.Reordered = true
+			break
+		}
+	}
+
+	if len(diff.Added) == 0 && len(diff.Removed) == 0 && !diff.Reordered {
+		return nil
+	}
+	return diff
+}
+
+// normalizedQueries returns the normalized queries sent to a tablet, in
+// logical time order
+func normalizedQueries(actions *TabletActions) []string {
+	if actions == nil {
+		return nil
+	}
+	queries := make([]*TabletQuery, len(actions.TabletQueries))
+	copy(queries, actions.TabletQueries)
+	sort.SliceStable(queries, func(i, j int) bool {
+		return queries[i].Time < queries[j].Time
+	})
+
+	normalized := make([]string, 0, len(queries))
+	for _, q := range queries {
+		normalized = append(normalized, normalizeQuery(q.SQL))
+	}
+	return normalized
+}
+
+// normalizeQuery returns the canonical form of a query, with all the
+// literals and bind variables replaced by the same placeholders. Queries
+// that can't be parsed are returned as is.
+func normalizeQuery(sql string) string {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return sql
+	}
+	sqlparser.Normalize(stmt, make(map[string]*querypb.BindVariable), "v")
+
+	buf := sqlparser.NewTrackedBuffer(func(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+		switch node := node.(type) {
+		case *sqlparser.SQLVal:
+			if node.Type == sqlparser.ValArg {
+				buf.WriteString(":v")
+				return
+			}
+		case sqlparser.ListArg:
+			buf.WriteString("::v")
+			return
+		}
+		node.Format(buf)
+	})
+	buf.Myprintf("%v", stmt)
+	return buf.String()
+}
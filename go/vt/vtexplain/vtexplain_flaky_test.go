@@ -17,13 +17,19 @@ limitations under the License.
 package vtexplain
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os/exec"
+	"reflect"
+	"sort"
 	"strings"
 	"testing"
 
+	"github.com/youtube/vitess/go/sqltypes"
 	"github.com/youtube/vitess/go/testfiles"
+
+	querypb "github.com/youtube/vitess/go/vt/proto/query"
 )
 
 var testOutputTempDir string
@@ -186,3 +192,397 @@ func TestErrors(t *testing.T) {
 		}
 	}
 }
+
+func TestStatementDurations(t *testing.T) {
+	opts := defaultTestOpts()
+	opts.StatementDurations = map[string]int{"select": 3}
+	initTest(opts, t)
+
+	sql := "select * from user where name = 'bob'"
+	explains, err := Run(sql)
+	if err != nil {
+		t.Fatalf("Run(%s): %v", sql, err)
+	}
+
+	// The lookup query takes 3 time units, so the query that depends on
+	// its result starts 3 units later.
+	var times []int
+	for _, actions := range explains[0].TabletActions {
+		for _, q := range actions.TabletQueries {
+			times = append(times, q.Time)
+		}
+	}
+	sort.Ints(times)
+	if want := []int{1, 4}; !reflect.DeepEqual(times, want) {
+		t.Errorf("Run(%s): query times %v, want %v", sql, times, want)
+	}
+}
+
+func TestLockingReads(t *testing.T) {
+	initTest(defaultTestOpts(), t)
+
+	tests := []struct {
+		sql  string
+		lock string
+	}{
+		{"select * from user where id = 1", ""},
+		{"select * from user where id = 1 for update", "for update"},
+		{"select * from user where id = 1 lock in share mode", "lock in share mode"},
+	}
+	for _, tcase := range tests {
+		explains, err := Run(tcase.sql)
+		if err != nil {
+			t.Fatalf("Run(%s): %v", tcase.sql, err)
+		}
+		for _, actions := range explains[0].TabletActions {
+			for _, q := range actions.TabletQueries {
+				if q.Lock != tcase.lock {
+					t.Errorf("Run(%s): %s has lock %q, want %q", tcase.sql, q.SQL, q.Lock, tcase.lock)
+				}
+			}
+		}
+	}
+}
+
+func TestListSizes(t *testing.T) {
+	initTest(defaultTestOpts(), t)
+
+	sql := "select * from user where id in (1, 2, 3)"
+	explains, err := Run(sql)
+	if err != nil {
+		t.Fatalf("Run(%s): %v", sql, err)
+	}
+	total := 0
+	for tablet, actions := range explains[0].TabletActions {
+		for _, q := range actions.TabletQueries {
+			size, ok := q.ListSizes["__vals"]
+			if !ok {
+				t.Errorf("Run(%s): %s on %s has no __vals list size: %v", sql, q.SQL, tablet, q.ListSizes)
+				continue
+			}
+			if want := len(q.BindVars["__vals"].Values); size != want {
+				t.Errorf("Run(%s): %s on %s has __vals list size %d, want %d", sql, q.SQL, tablet, size, want)
+			}
+			total += size
+		}
+	}
+	if total != 3 {
+		t.Errorf("Run(%s): %d values sent to the tablets, want 3", sql, total)
+	}
+}
+
+func TestTabletQueriesAsJSON(t *testing.T) {
+	explains := []*Explain{{
+		SQL: "select * from user where id in (1, 2)",
+		TabletActions: map[string]*TabletActions{
+			"ks_sharded/-40": {
+				TabletQueries: []*TabletQuery{{
+					Time: 1,
+					SQL:  "select * from user where id in ::__vals",
+					BindVars: map[string]*querypb.BindVariable{
+						"__vals": {
+							Type: querypb.Type_TUPLE,
+							Values: []*querypb.Value{
+								{Type: querypb.Type_INT64, Value: []byte("1")},
+								{Type: querypb.Type_INT64, Value: []byte("2")},
+							},
+						},
+						"name": sqltypes.StringBindVariable("bob"),
+					},
+					TransactionID: 1510000000000000001,
+				}},
+			},
+		},
+	}}
+
+	want := `[
+    {
+        "SQL": "select * from user where id in (1, 2)",
+        "TabletQueries": {
+            "ks_sharded/-40": [
+                {
+                    "Time": 1,
+                    "SQL": "select * from user where id in ::__vals",
+                    "BindVars": {
+                        "__vals": {
+                            "Type": "TUPLE",
+                            "Values": [
+                                1,
+                                2
+                            ],
+                            "Count": 2
+                        },
+                        "name": {
+                            "Type": "VARCHAR",
+                            "Value": "bob"
+                        }
+                    },
+                    "Transaction": 1
+                }
+            ]
+        }
+    }
+]
+`
+	if got := TabletQueriesAsJSON(explains); got != want {
+		t.Errorf("TabletQueriesAsJSON: got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestExplainsAsMarkdown(t *testing.T) {
+	explains := []*Explain{{
+		SQL: "select * from user where id in (1, 2)",
+		TabletActions: map[string]*TabletActions{
+			"ks_sharded/40-80": {
+				MysqlQueries: []*MysqlQuery{
+					{Time: 1, SQL: "select * from user where id in (2) limit 10001"},
+				},
+			},
+			"ks_sharded/-40": {
+				MysqlQueries: []*MysqlQuery{
+					{Time: 2, SQL: "select a | b from user"},
+					{Time: 1, SQL: "select * from user where id in (1) limit 10001"},
+				},
+			},
+		},
+	}}
+
+	want := "## Query 1\n" +
+		"\n" +
+		"```sql\n" +
+		"select * from user where id in (1, 2)\n" +
+		"```\n" +
+		"\n" +
+		"### ks_sharded/-40\n" +
+		"\n" +
+		"| Time | Shard | SQL |\n" +
+		"|------|-------|-----|\n" +
+		"| 1 | -40 | select * from user where id in (1) limit 10001 |\n" +
+		"| 2 | -40 | select a \\| b from user |\n" +
+		"\n" +
+		"### ks_sharded/40-80\n" +
+		"\n" +
+		"| Time | Shard | SQL |\n" +
+		"|------|-------|-----|\n" +
+		"| 1 | 40-80 | select * from user where id in (2) limit 10001 |\n" +
+		"\n"
+	if got := ExplainsAsMarkdown(explains); got != want {
+		t.Errorf("ExplainsAsMarkdown: got\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestDiffTabletQueries(t *testing.T) {
+	actions := func(sqls ...string) *TabletActions {
+		ta := &TabletActions{}
+		for i, sql := range sqls {
+			ta.TabletQueries = append(ta.TabletQueries, &TabletQuery{Time: i + 1, SQL: sql})
+		}
+		return ta
+	}
+
+	before := map[string]*TabletActions{
+		"ks_sharded/-40": actions(
+			"select * from user where id = :vtg1",
+			"select name from user where id in ::__vals",
+		),
+		"ks_sharded/40-80": actions(
+			"update user set name = 'a' where id = 1",
+			"insert into music(id) values (1)",
+		),
+		"ks_sharded/80-c0": actions("select * from user where id = 1"),
+	}
+	after := map[string]*TabletActions{
+		// Only literals and bind variable names changed.
+		"ks_sharded/-40": actions(
+			"select * from user where id = 42",
+			"select name from user where id in ::vals",
+		),
+		"ks_sharded/40-80": actions(
+			"insert into music(id) values (2)",
+			"update user set name = 'b' where id = 2",
+		),
+		"ks_sharded/80-c0": actions("select * from user where name = 'x'"),
+		"ks_sharded/c0-":   actions("select 1 from dual"),
+	}
+
+	got := DiffTabletQueries(before, after)
+	want := []*TabletQueriesDiff{{
+		Tablet:    "ks_sharded/40-80",
+		Reordered: true,
+	}, {
+		Tablet:  "ks_sharded/80-c0",
+		Added:   []string{"select * from user where name = :v"},
+		Removed: []string{"select * from user where id = :v"},
+	}, {
+		Tablet: "ks_sharded/c0-",
+		Added:  []string{"select :v from dual"},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		for _, d := range got {
+			t.Logf("got %+v", d)
+		}
+		t.Errorf("DiffTabletQueries: got %v, want %v", got, want)
+	}
+}
+
+func TestIndependentInstances(t *testing.T) {
+	schema, err := ioutil.ReadFile(testfiles.Locate("vtexplain/test-schema.sql"))
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	vSchema, err := ioutil.ReadFile(testfiles.Locate("vtexplain/test-vschema.json"))
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+
+	opts1 := defaultTestOpts()
+	opts1.NumShards = 2
+	vte1, err := New(string(vSchema), string(schema), opts1)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	defer vte1.Stop()
+
+	vte2, err := New(string(vSchema), string(schema), defaultTestOpts())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	defer vte2.Stop()
+
+	// A scatter select reaches every shard of the instance that ran it.
+	sql := "select * from user"
+	for _, tcase := range []struct {
+		vte    *VTExplain
+		shards int
+	}{
+		{vte1, 2},
+		{vte2, 4},
+		{vte1, 2},
+	} {
+		explains, err := tcase.vte.Run(sql)
+		if err != nil {
+			t.Fatalf("Run(%s): %v", sql, err)
+		}
+		if got := len(explains[0].TabletActions); got != tcase.shards {
+			t.Errorf("Run(%s): queries sent to %d tablets, want %d", sql, got, tcase.shards)
+		}
+	}
+}
+
+func TestKeyspaceSchemas(t *testing.T) {
+	vSchema := `{
+	"ks1": {"Sharded": false, "Tables": {"t": {}}},
+	"ks2": {"Sharded": false, "Tables": {"t": {}, "u": {}}}
+}`
+	schema := `
+create table ks1.t (id bigint, a varchar(64), primary key (id));
+create table ks2.t (id bigint, b varchar(64), primary key (id));
+create table u (id bigint, primary key (id));
+`
+	opts := defaultTestOpts()
+	opts.ColumnValues = map[string][]string{"t.b": {"x"}}
+	vte, err := New(vSchema, schema, opts)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	defer vte.Stop()
+
+	for _, sql := range []string{
+		"select a from ks1.t",
+		"select b from ks2.t",
+		"select id from ks2.u",
+	} {
+		if _, err := vte.Run(sql); err != nil {
+			t.Errorf("Run(%s): %v", sql, err)
+		}
+	}
+
+	sql := "select b from ks1.t"
+	if _, err := vte.Run(sql); err == nil || !strings.Contains(err.Error(), "invalid column b") {
+		t.Errorf("Run(%s): %v, want invalid column b", sql, err)
+	}
+
+	schema += "create table ks3.t (id bigint);"
+	want := "initVtgateExecutor: schema has tables for keyspace ks3 which is not in the vschema"
+	if _, err := New(vSchema, schema, defaultTestOpts()); err == nil || err.Error() != want {
+		t.Errorf("New with a schema for an unknown keyspace: %v, want %s", err, want)
+	}
+}
+
+func TestSchemaAsJSON(t *testing.T) {
+	vSchema := `{"ks1": {"Sharded": false, "Tables": {"t1": {}, "t2": {}}}}`
+	schema := `
+create table t2 (id bigint auto_increment, name varchar(64) not null, primary key (id), unique key name_idx (name));
+create table ks1.t1 (id int unsigned);
+`
+	vte, err := New(vSchema, schema, defaultTestOpts())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	defer vte.Stop()
+
+	t2 := &schemaTable{
+		Name: "t2",
+		Columns: []*schemaColumn{
+			{Name: "id", Type: "INT64", ColumnType: "bigint", AutoIncrement: true},
+			{Name: "name", Type: "VARCHAR", ColumnType: "varchar(64)"},
+		},
+		Indexes: []*schemaIndex{
+			{Name: "PRIMARY", Columns: []string{"id"}, Primary: true, Unique: true},
+			{Name: "name_idx", Columns: []string{"name"}, Unique: true},
+		},
+	}
+	want := &schemaOutput{
+		Tables: []*schemaTable{t2},
+		Keyspaces: map[string][]*schemaTable{
+			"ks1": {{
+				Name: "t1",
+				Columns: []*schemaColumn{
+					{Name: "id", Type: "UINT32", ColumnType: "int unsigned", Nullable: true},
+				},
+			}, t2},
+		},
+	}
+
+	got := &schemaOutput{}
+	if err := json.Unmarshal([]byte(vte.SchemaAsJSON()), got); err != nil {
+		t.Fatalf("SchemaAsJSON: invalid json: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		gotJSON, _ := json.Marshal(got)
+		wantJSON, _ := json.Marshal(want)
+		t.Errorf("SchemaAsJSON: got %s, want %s", gotJSON, wantJSON)
+	}
+}
+
+func TestTableAccesses(t *testing.T) {
+	vSchema := `{"ks1": {"Sharded": false, "Tables": {"t1": {}, "t2": {}}}}`
+	schema := `
+create table t1 (id bigint, name varchar(64), primary key (id));
+create table t2 (id bigint, primary key (id));
+`
+	vte, err := New(vSchema, schema, defaultTestOpts())
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	defer vte.Stop()
+
+	sql := "select * from t1;" +
+		"select t1.id from t1 join t2 on t1.id = t2.id;" +
+		"insert into t2(id) values (1);" +
+		"update t1 set name = 'a' where id = 1"
+	explains, err := vte.Run(sql)
+	if err != nil {
+		t.Fatalf("Run(%s): %v", sql, err)
+	}
+
+	want := []*TableAccess{
+		{Tablet: "ks1/-", Table: "t1", Reads: 2, Writes: 1},
+		{Tablet: "ks1/-", Table: "t2", Reads: 1, Writes: 1},
+	}
+	if got := TableAccesses(explains); !reflect.DeepEqual(got, want) {
+		gotJSON, _ := json.Marshal(got)
+		wantJSON, _ := json.Marshal(want)
+		t.Errorf("TableAccesses: got %s, want %s", gotJSON, wantJSON)
+	}
+}
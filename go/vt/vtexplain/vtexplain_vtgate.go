@@ -40,31 +40,24 @@ import (
 	vtgatepb "github.com/youtube/vitess/go/vt/proto/vtgate"
 )
 
-var (
-	explainTopo    *ExplainTopo
-	vtgateExecutor *vtgate.Executor
-	healthCheck    *discovery.FakeHealthCheck
-
-	vtgateSession = &vtgatepb.Session{
+func (vte *VTExplain) initVtgateExecutor(vSchemaStr string, opts *Options) error {
+	vte.explainTopo = &ExplainTopo{NumShards: opts.NumShards}
+	vte.healthCheck = discovery.NewFakeHealthCheck()
+	vte.vtgateSession = &vtgatepb.Session{
 		TargetString: "@master",
 		Autocommit:   true,
 	}
-)
 
-func initVtgateExecutor(vSchemaStr string, opts *Options) error {
-	explainTopo = &ExplainTopo{NumShards: opts.NumShards}
-	healthCheck = discovery.NewFakeHealthCheck()
+	resolver := newFakeResolver(vte.healthCheck, vte.explainTopo, vtexplainCell)
 
-	resolver := newFakeResolver(healthCheck, explainTopo, vtexplainCell)
-
-	err := buildTopology(vSchemaStr, opts.NumShards)
+	err := vte.buildTopology(vSchemaStr, opts.NumShards)
 	if err != nil {
 		return err
 	}
 
 	streamSize := 10
 	queryCacheSize := int64(10)
-	vtgateExecutor = vtgate.NewExecutor(context.Background(), explainTopo, vtexplainCell, "", resolver, opts.Normalize, streamSize, queryCacheSize)
+	vte.vtgateExecutor = vtgate.NewExecutor(context.Background(), vte.explainTopo, vtexplainCell, "", resolver, opts.Normalize, streamSize, queryCacheSize)
 
 	return nil
 }
@@ -77,18 +70,24 @@ func newFakeResolver(hc discovery.HealthCheck, serv topo.SrvTopoServer, cell str
 	return vtgate.NewResolver(serv, cell, sc)
 }
 
-func buildTopology(vschemaStr string, numShardsPerKeyspace int) error {
-	explainTopo.Lock.Lock()
-	defer explainTopo.Lock.Unlock()
+func (vte *VTExplain) buildTopology(vschemaStr string, numShardsPerKeyspace int) error {
+	vte.explainTopo.Lock.Lock()
+	defer vte.explainTopo.Lock.Unlock()
 
-	explainTopo.Keyspaces = make(map[string]*vschemapb.Keyspace)
-	err := json.Unmarshal([]byte(vschemaStr), &explainTopo.Keyspaces)
+	vte.explainTopo.Keyspaces = make(map[string]*vschemapb.Keyspace)
+	err := json.Unmarshal([]byte(vschemaStr), &vte.explainTopo.Keyspaces)
 	if err != nil {
 		return err
 	}
 
-	explainTopo.TabletConns = make(map[string]*explainTablet)
-	for ks, vschema := range explainTopo.Keyspaces {
+	for ks := range vte.keyspaceEnvs {
+		if _, ok := vte.explainTopo.Keyspaces[ks]; !ok {
+			return fmt.Errorf("schema has tables for keyspace %s which is not in the vschema", ks)
+		}
+	}
+
+	vte.explainTopo.TabletConns = make(map[string]*explainTablet)
+	for ks, vschema := range vte.explainTopo.Keyspaces {
 		numShards := 1
 		if vschema.Sharded {
 			numShards = numShardsPerKeyspace
@@ -102,25 +101,33 @@ func buildTopology(vschemaStr string, numShardsPerKeyspace int) error {
 			hostname := fmt.Sprintf("%s/%s", ks, shard)
 			log.Infof("registering test tablet %s for keyspace %s shard %s", hostname, ks, shard)
 
-			tablet := healthCheck.AddFakeTablet(vtexplainCell, hostname, 1, ks, shard, topodatapb.TabletType_MASTER, true, 1, nil, func(t *topodatapb.Tablet) queryservice.QueryService {
-				return newTablet(t)
+			tablet := vte.healthCheck.AddFakeTablet(vtexplainCell, hostname, 1, ks, shard, topodatapb.TabletType_MASTER, true, 1, nil, func(t *topodatapb.Tablet) queryservice.QueryService {
+				tablet := newTablet(vte.keyspaceEnv(t.Keyspace), t)
+				tablet.databaseEnv = vte.databaseEnv
+				return tablet
 			})
-			explainTopo.TabletConns[hostname] = tablet.(*explainTablet)
+			vte.explainTopo.TabletConns[hostname] = tablet.(*explainTablet)
 		}
 	}
 
 	return err
 }
 
-func vtgateExecute(sql string) ([]*engine.Plan, map[string]*TabletActions, error) {
-	_, err := vtgateExecutor.Execute(context.Background(), vtgateSession, sql, nil)
+func (vte *VTExplain) vtgateExecute(sql string) ([]*engine.Plan, map[string]*TabletActions, error) {
+	ctx := context.Background()
+	if vte.queryTimeout != 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, vte.queryTimeout)
+		defer cancel()
+	}
+	_, err := vte.vtgateExecutor.Execute(ctx, vte.vtgateSession, sql, nil)
 	if err != nil {
 		return nil, nil, fmt.Errorf("vtexplain execute error: %v in %s", err, sql)
 	}
 
 	// use the plan cache to get the set of plans used for this query, then
 	// clear afterwards for the next run
-	planCache := vtgateExecutor.Plans()
+	planCache := vte.vtgateExecutor.Plans()
 	var plans []*engine.Plan
 	for _, item := range planCache.Items() {
 		plans = append(plans, item.Value.(*engine.Plan))
@@ -128,7 +135,7 @@ func vtgateExecute(sql string) ([]*engine.Plan, map[string]*TabletActions, error
 	planCache.Clear()
 
 	tabletActions := make(map[string]*TabletActions)
-	for shard, tc := range explainTopo.TabletConns {
+	for shard, tc := range vte.explainTopo.TabletConns {
 		if len(tc.tabletQueries) == 0 {
 			continue
 		}
@@ -17,9 +17,16 @@ limitations under the License.
 package vtexplain
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"golang.org/x/net/context"
 
@@ -32,6 +39,7 @@ import (
 	"github.com/youtube/vitess/go/vt/dbconfigs"
 	"github.com/youtube/vitess/go/vt/mysqlctl"
 	"github.com/youtube/vitess/go/vt/sqlparser"
+	"github.com/youtube/vitess/go/vt/vterrors"
 
 	"github.com/youtube/vitess/go/vt/vttablet/queryservice"
 	"github.com/youtube/vitess/go/vt/vttablet/tabletserver"
@@ -39,19 +47,356 @@ import (
 
 	querypb "github.com/youtube/vitess/go/vt/proto/query"
 	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+	vtrpcpb "github.com/youtube/vitess/go/vt/proto/vtrpc"
 )
 
-var (
+// showCreateTableFields contains the fields returned by a
+// 'show create table <table>' command.
+var showCreateTableFields = []*querypb.Field{{
+	Name: "Table",
+	Type: sqltypes.VarChar,
+}, {
+	Name: "Create Table",
+	Type: sqltypes.VarChar,
+}}
+
+// showFullColumnsFields contains the fields returned by a
+// 'show full columns from <table>' command.
+var showFullColumnsFields = []*querypb.Field{
+	{Name: "Field", Type: sqltypes.VarChar},
+	{Name: "Type", Type: sqltypes.Text},
+	{Name: "Collation", Type: sqltypes.VarChar},
+	{Name: "Null", Type: sqltypes.VarChar},
+	{Name: "Key", Type: sqltypes.VarChar},
+	{Name: "Default", Type: sqltypes.Text},
+	{Name: "Extra", Type: sqltypes.VarChar},
+	{Name: "Privileges", Type: sqltypes.VarChar},
+	{Name: "Comment", Type: sqltypes.Text},
+}
+
+// showFullColumnsRow returns the 'show full columns' row for a column given
+// its describe row and collation.
+func showFullColumnsRow(describeRow []sqltypes.Value, collation string) []sqltypes.Value {
+	row := make([]sqltypes.Value, 0, len(showFullColumnsFields))
+	row = append(row, describeRow[:2]...)
+	row = append(row, nullableVarChar(collation))
+	row = append(row, describeRow[2:]...)
+	return append(row,
+		sqltypes.NewVarChar("select,insert,update,references"),
+		sqltypes.MakeTrusted(sqltypes.Text, []byte("")),
+	)
+}
+
+// defaultCharset is the database default character set of the simulated
+// mysql, used for tables that don't declare one.
+const defaultCharset = "utf8"
+
+// charsetCollations maps the common character sets to their default
+// collation.
+var charsetCollations = map[string]string{
+	"ascii":   "ascii_general_ci",
+	"binary":  "binary",
+	"latin1":  "latin1_swedish_ci",
+	"utf8":    "utf8_general_ci",
+	"utf8mb4": "utf8mb4_general_ci",
+}
+
+// resolveCollation returns the character set and collation that result from
+// the given, possibly empty, charset and collate options, falling back to
+// the given defaults when neither is set.
+func resolveCollation(charset, collation, defaultCharset, defaultCollation string) (string, string) {
+	charset = strings.ToLower(charset)
+	collation = strings.ToLower(collation)
+	switch {
+	case charset == "" && collation == "":
+		return defaultCharset, defaultCollation
+	case charset == "":
+		// a collation name always starts with its charset
+		charset = strings.SplitN(collation, "_", 2)[0]
+	case collation == "":
+		collation = charsetCollations[charset]
+		if collation == "" {
+			collation = charset + "_general_ci"
+		}
+	}
+	return charset, collation
+}
+
+// parseTableCharset returns the default character set and collation of a
+// table given its table options, such as "ENGINE=InnoDB DEFAULT CHARSET=latin1".
+func parseTableCharset(options string) (string, string) {
+	words := strings.FieldsFunc(strings.ToLower(options), func(r rune) bool {
+		return r == ' ' || r == '=' || r == ','
+	})
+	var charset, collation string
+	for i := 0; i < len(words)-1; i++ {
+		switch {
+		case words[i] == "charset":
+			charset = words[i+1]
+		case words[i] == "character" && words[i+1] == "set" && i+2 < len(words):
+			charset = words[i+2]
+		case words[i] == "collate":
+			collation = words[i+1]
+		}
+	}
+	return resolveCollation(charset, collation, defaultCharset, charsetCollations[defaultCharset])
+}
+
+// hasCollation returns true if columns of the given type have a character
+// set and collation.
+func hasCollation(typ querypb.Type) bool {
+	return sqltypes.IsText(typ) || typ == sqltypes.Enum || typ == sqltypes.Set
+}
+
+// showTableStatusFields contains the fields returned by a
+// 'show table status' command.
+var showTableStatusFields = []*querypb.Field{
+	{Name: "Name", Type: sqltypes.VarChar},
+	{Name: "Engine", Type: sqltypes.VarChar},
+	{Name: "Version", Type: sqltypes.Uint64},
+	{Name: "Row_format", Type: sqltypes.VarChar},
+	{Name: "Rows", Type: sqltypes.Uint64},
+	{Name: "Avg_row_length", Type: sqltypes.Uint64},
+	{Name: "Data_length", Type: sqltypes.Uint64},
+	{Name: "Max_data_length", Type: sqltypes.Uint64},
+	{Name: "Index_length", Type: sqltypes.Uint64},
+	{Name: "Data_free", Type: sqltypes.Uint64},
+	{Name: "Auto_increment", Type: sqltypes.Uint64},
+	{Name: "Create_time", Type: sqltypes.Datetime},
+	{Name: "Update_time", Type: sqltypes.Datetime},
+	{Name: "Check_time", Type: sqltypes.Datetime},
+	{Name: "Collation", Type: sqltypes.VarChar},
+	{Name: "Checksum", Type: sqltypes.Uint64},
+	{Name: "Create_options", Type: sqltypes.VarChar},
+	{Name: "Comment", Type: sqltypes.VarChar},
+}
+
+// showTableStatusRow returns the 'show table status' row for the given
+// table. The row count is the same one used to generate select results,
+// and tables with an auto_increment column report the first id that a
+// simulated insert would be assigned.
+func (env *tabletEnv) showTableStatusRow(table string) []sqltypes.Value {
+	autoIncrement := sqltypes.NULL
+	if env.tableAutoIncrement[table] != "" {
+		autoIncrement = sqltypes.NewUint64(1)
+	}
+	return []sqltypes.Value{
+		sqltypes.NewVarChar(table),
+		sqltypes.NewVarChar("InnoDB"),
+		sqltypes.NewUint64(10),
+		sqltypes.NewVarChar("Dynamic"),
+		sqltypes.NewUint64(uint64(env.tableNumRows(table))),
+		sqltypes.NewUint64(0),
+		sqltypes.NewUint64(16384),
+		sqltypes.NewUint64(0),
+		sqltypes.NewUint64(0),
+		sqltypes.NewUint64(0),
+		autoIncrement,
+		sqltypes.NULL,
+		sqltypes.NULL,
+		sqltypes.NULL,
+		sqltypes.NewVarChar(env.tableCollations[table]),
+		sqltypes.NULL,
+		sqltypes.NewVarChar(""),
+		sqltypes.NewVarChar(""),
+	}
+}
+
+// tabletEnv holds the schema and options shared by all the simulated
+// tablets of a VTExplain.
+type tabletEnv struct {
 	// map of schema introspection queries to their expected results
 	schemaQueries map[string]*sqltypes.Result
 
 	// map for each table from the column name to its type
 	tableColumns map[string]map[string]querypb.Type
 
+	// map for each table from the column name to its definition, used to
+	// generate values that honor the column's default and nullability
+	tableColumnDefs map[string]map[string]*sqlparser.ColumnType
+
+	// map for each table to its column names, in table order
+	tableColumnNames map[string][]string
+
+	// map for each table to its auto_increment column, if any
+	tableAutoIncrement map[string]string
+
+	// map for each table to its primary key columns, in index order
+	tablePKColumns map[string][]string
+
+	// map for each table to the columns of each of its indexes, in index
+	// order
+	tableIndexes map[string][][]string
+
+	// map for each table to the name and kind of each of its indexes, in
+	// the same order as tableIndexes
+	tableIndexInfos map[string][]*sqlparser.IndexInfo
+
+	// map for each table to its default collation
+	tableCollations map[string]string
+
+	// map for each table from the column name to its collation, for the
+	// columns that have one
+	columnCollations map[string]map[string]string
+
+	// map from the definition of a column (as stored in tableColumnDefs)
+	// to the values that generated rows use in turn for that column
+	columnValues map[*sqlparser.ColumnType][]string
+
+	// map for each table to the number of rows returned by queries on it,
+	// and the number to use for tables that aren't in the map
+	tableRowCounts  map[string]int
+	defaultRowCount int
+
+	// map of information_schema table name to the full contents of that
+	// table, which are filtered and projected for each query
+	infoSchemaTables map[string]*sqltypes.Result
+
+	// queries that should fail instead of returning a result
+	errorQueries []*errorQuery
+
 	// time simulator
 	batchTime *sync2.Batcher
+
+	// number of logical time units taken by each kind of statement
+	statementDurations map[string]int
+
+	// latency of the queries sent to each shard, and the seed of the
+	// random jitter of each tablet
+	shardLatencies map[string]ShardLatency
+	latencySeed    int64
+
+	// whether queries may contain multiple statements
+	multiStatements bool
+
+	// number of rows of each result of a streaming query, or zero to
+	// leave the results as they are
+	streamBatchSize int
+
+	// whether rows written by replace statements conflict with existing rows
+	replaceConflict bool
+
+	// whether rows written by inserts with an on duplicate key update
+	// clause conflict with existing rows
+	duplicateKeyConflict bool
+
+	// whether the values written by inserts are recorded on the queries
+	recordInsertValues bool
+
+	// generator of the values of the selected columns, or nil to use
+	// generateValue
+	valueGenerator ValueGenerator
+
+	// hook called with each simulated statement, if any
+	queryHook QueryHook
+
+	// whether inserts must set the not null columns without a default
+	strictNotNull bool
+
+	// whether queries fail when they compare a column for equality with a
+	// bind variable of a mismatched type
+	bindVarTypeChecks bool
+
+	// the global sql_mode of the simulated mysql
+	sqlMode string
+
+	// the server version of the simulated mysql
+	mysqlVersion string
+
+	// the initial session time zone, which the current time and the
+	// generated temporal values are in
+	timeZone string
+
+	// the simulated time each query takes on the tablets, checked against
+	// the deadline of the query's context
+	queryLatency time.Duration
+
+	// the result of unix_timestamp(), and the time used for generated
+	// temporal values
+	unixTimestamp int64
+	baseTime      time.Time
+}
+
+// defaultUnixTimestamp and defaultBaseTime are used for the simulated clock
+// unless Options.UnixTimestamp is set.
+var (
+	defaultUnixTimestamp int64 = 1427325875
+	defaultBaseTime            = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
 )
 
+// defaultSQLMode is the sql_mode of the simulated mysql unless
+// Options.SQLMode is set.
+const defaultSQLMode = "STRICT_TRANS_TABLES"
+
+// defaultMysqlVersion is the server version of the simulated mysql unless
+// Options.MysqlVersion is set.
+const defaultMysqlVersion = "5.7.20"
+
+// defaultTimeZone is the session time zone of the simulated mysql unless
+// Options.TimeZone is set. Its system time zone is UTC.
+const defaultTimeZone = "SYSTEM"
+
+// timeZoneLocation returns the location of a mysql time zone, which is
+// SYSTEM, an offset like +05:30, or a named zone like Europe/Paris.
+func timeZoneLocation(timeZone string) (*time.Location, error) {
+	if strings.EqualFold(timeZone, defaultTimeZone) {
+		return time.UTC, nil
+	}
+	if offset, err := time.Parse("-07:00", timeZone); err == nil {
+		_, seconds := offset.Zone()
+		return time.FixedZone(timeZone, seconds), nil
+	}
+	return time.LoadLocation(timeZone)
+}
+
+// stringVarResult returns the result of a query for a string system
+// variable, e.g. the sql_mode.
+func stringVarResult(val string) *sqltypes.Result {
+	return &sqltypes.Result{
+		Fields: []*querypb.Field{{
+			Type: sqltypes.VarChar,
+		}},
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewVarBinary(val)},
+		},
+	}
+}
+
+// statementKinds maps the statement types from sqlparser.Preview to the names
+// used to configure their simulated durations.
+var statementKinds = map[int]string{
+	sqlparser.StmtSelect:  "select",
+	sqlparser.StmtInsert:  "insert",
+	sqlparser.StmtReplace: "replace",
+	sqlparser.StmtUpdate:  "update",
+	sqlparser.StmtDelete:  "delete",
+}
+
+// systemVarQueries maps the supported queries for system variables to the
+// scoped name of the variable, as tracked in explainTablet.systemVars, and
+// the type of the result field.
+var systemVarQueries = map[string]struct {
+	name string
+	typ  querypb.Type
+}{
+	"select @@autocommit":         {"session.autocommit", sqltypes.Uint64},
+	"select @@session.autocommit": {"session.autocommit", sqltypes.Uint64},
+	"select @@sql_mode":           {"session.sql_mode", sqltypes.VarChar},
+	"select @@session.sql_mode":   {"session.sql_mode", sqltypes.VarChar},
+	"select @@global.sql_mode":    {"global.sql_mode", sqltypes.VarChar},
+	"select @@time_zone":          {"session.time_zone", sqltypes.VarChar},
+	"select @@session.time_zone":  {"session.time_zone", sqltypes.VarChar},
+	"select @@global.time_zone":   {"global.time_zone", sqltypes.VarChar},
+}
+
+// errorQuery is a query pattern for which the simulated mysql returns the
+// given error.
+type errorQuery struct {
+	expr *regexp.Regexp
+	err  error
+}
+
 // explainTablet is the query service that simulates a tablet.
 //
 // To avoid needing to boilerplate implement the unneeded portions of the
@@ -61,20 +406,61 @@ var (
 type explainTablet struct {
 	queryservice.QueryService
 
+	env           *tabletEnv
 	db            *fakesqldb.DB
 	tsv           *tabletserver.TabletServer
 	tabletQueries []*TabletQuery
 	mysqlQueries  []*MysqlQuery
 	currentTime   int
+
+	// last generated auto_increment value for each table
+	autoIncrement map[string]uint64
+
+	// values of the tracked system variables applied with SET, keyed by
+	// scope and name, e.g. "session.sql_mode"
+	systemVars map[string]string
+
+	// source of the jitter of the shard latency, seeded for each tablet
+	// so that the timeline doesn't depend on the order in which
+	// concurrent queries reach the tablets
+	latencySeed int64
+	latencyRand *rand.Rand
+
+	// hook called with each simulated statement, set once the tablet has
+	// started so that it isn't called for the queries of the startup
+	queryHook QueryHook
+
+	// the keyspace of the tablet and its environment, which is used unless
+	// a USE statement switched to the database of another keyspace
+	keyspace    string
+	keyspaceEnv *tabletEnv
+
+	// returns the environment of the named keyspace, or false if there is
+	// no such keyspace. Only the tablet's own keyspace is known if nil.
+	databaseEnv func(keyspace string) (*tabletEnv, bool)
 }
 
-func newTablet(t *topodatapb.Tablet) *explainTablet {
+func newTablet(env *tabletEnv, t *topodatapb.Tablet) *explainTablet {
 	db := fakesqldb.New(nil)
 
 	// XXX much of this is cloned from the tabletserver tests
-	tsv := tabletserver.NewTabletServerWithNilTopoServer(tabletenv.DefaultQsConfig)
+	config := tabletenv.DefaultQsConfig
+	// Like a real vttablet, the tabletserver only starts without
+	// STRICT_TRANS_TABLES if it isn't enforced.
+	if !strings.Contains(env.sqlMode, "STRICT_TRANS_TABLES") {
+		config.EnforceStrictTransTables = false
+	}
+	tsv := tabletserver.NewTabletServerWithNilTopoServer(config)
 
-	tablet := explainTablet{db: db, tsv: tsv}
+	tablet := explainTablet{
+		env:         env,
+		db:          db,
+		tsv:         tsv,
+		latencySeed: env.latencySeed + tabletSeed(t),
+		keyspace:    t.Keyspace,
+		keyspaceEnv: env,
+	}
+	tablet.Reset()
 	db.Handler = &tablet
 
 	tablet.QueryService = queryservice.Wrap(
@@ -105,328 +491,2872 @@ func newTablet(t *topodatapb.Tablet) *explainTablet {
 
 	// clear all the schema initialization queries out of the tablet
 	// to avoid clutttering the output
-	tablet.mysqlQueries = nil
+	tablet.Reset()
+	tablet.queryHook = env.queryHook
 
 	return &tablet
 }
 
+// Reset clears the queries recorded by the tablet and restores its logical
+// time, auto_increment values, system variables and latency jitter to their
+// initial state, so that it can be reused for an independent run. It also
+// switches back to the database of the tablet's keyspace.
+func (t *explainTablet) Reset() {
+	t.env = t.keyspaceEnv
+	t.tabletQueries = nil
+	t.mysqlQueries = nil
+	t.currentTime = 0
+	t.autoIncrement = make(map[string]uint64)
+	t.systemVars = make(map[string]string)
+	t.latencyRand = rand.New(rand.NewSource(t.latencySeed))
+}
+
 var _ queryservice.QueryService = (*explainTablet)(nil) // compile-time interface check
 
+// tabletSeed returns a number derived from the keyspace and shard of a
+// tablet, used to seed each tablet differently.
+func tabletSeed(t *topodatapb.Tablet) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(t.Keyspace + "/" + t.Shard))
+	return int64(h.Sum64())
+}
+
+// queryTime blocks until the logical time at which a query sent to the
+// tablet starts, which is delayed by the simulated latency of its shard,
+// and returns that time.
+func (t *explainTablet) queryTime(target *querypb.Target) int {
+	currentTime := t.env.batchTime.Wait()
+	latency, ok := t.env.shardLatencies[target.GetShard()]
+	if !ok {
+		return currentTime
+	}
+	delay := latency.Base
+	if latency.Jitter > 0 {
+		delay += t.latencyRand.Intn(latency.Jitter + 1)
+	}
+	for i := 0; i < delay; i++ {
+		currentTime = t.env.batchTime.Wait()
+	}
+	return currentTime
+}
+
 // Begin is part of the QueryService interface.
 func (t *explainTablet) Begin(ctx context.Context, target *querypb.Target, options *querypb.ExecuteOptions) (int64, error) {
-	t.currentTime = batchTime.Wait()
+	t.currentTime = t.env.batchTime.Wait()
 	return t.tsv.Begin(ctx, target, options)
 }
 
 // Commit is part of the QueryService interface.
 func (t *explainTablet) Commit(ctx context.Context, target *querypb.Target, transactionID int64) error {
-	t.currentTime = batchTime.Wait()
+	t.currentTime = t.env.batchTime.Wait()
 	return t.tsv.Commit(ctx, target, transactionID)
 }
 
 // Rollback is part of the QueryService interface.
 func (t *explainTablet) Rollback(ctx context.Context, target *querypb.Target, transactionID int64) error {
-	t.currentTime = batchTime.Wait()
+	t.currentTime = t.env.batchTime.Wait()
 	return t.tsv.Rollback(ctx, target, transactionID)
 }
 
 // Execute is part of the QueryService interface.
 func (t *explainTablet) Execute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
-	t.currentTime = batchTime.Wait()
+	t.currentTime = t.queryTime(target)
 
 	// Since the query is simulated being "sent" over the wire we need to
 	// copy the bindVars into the executor to avoid a data race.
 	bindVariables = sqltypes.CopyBindVariables(bindVariables)
-	t.tabletQueries = append(t.tabletQueries, &TabletQuery{
-		Time:     t.currentTime,
-		SQL:      sql,
-		BindVars: bindVariables,
-	})
+	tq, stmt := t.newTabletQuery(sql, bindVariables, transactionID)
+	t.tabletQueries = append(t.tabletQueries, tq)
+	if err := t.checkDeadline(ctx); err != nil {
+		return nil, err
+	}
+	if err := t.env.bindVarTypeError(stmt, bindVariables); err != nil {
+		return nil, err
+	}
+	defer t.simulateDuration(sql)
 	return t.tsv.Execute(ctx, target, sql, bindVariables, transactionID, options)
 }
 
 // BeginExecute is part of the QueryService interface.
 func (t *explainTablet) BeginExecute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]*querypb.BindVariable, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
-	t.currentTime = batchTime.Wait()
+	t.currentTime = t.queryTime(target)
 	bindVariables = sqltypes.CopyBindVariables(bindVariables)
-	t.tabletQueries = append(t.tabletQueries, &TabletQuery{
-		Time:     t.currentTime,
-		SQL:      sql,
-		BindVars: bindVariables,
-	})
-	return t.tsv.BeginExecute(ctx, target, sql, bindVariables, options)
-}
-
-// Close is part of the QueryService interface.
-func (t *explainTablet) Close(ctx context.Context) error {
-	return t.tsv.Close(ctx)
+	tq, stmt := t.newTabletQuery(sql, bindVariables, 0)
+	t.tabletQueries = append(t.tabletQueries, tq)
+	if err := t.checkDeadline(ctx); err != nil {
+		return nil, 0, err
+	}
+	if err := t.env.bindVarTypeError(stmt, bindVariables); err != nil {
+		return nil, 0, err
+	}
+	defer t.simulateDuration(sql)
+	result, transactionID, err := t.tsv.BeginExecute(ctx, target, sql, bindVariables, options)
+	tq.TransactionID = transactionID
+	return result, transactionID, err
 }
 
-func initTabletEnvironment(ddls []*sqlparser.DDL, opts *Options) error {
-	tableColumns = make(map[string]map[string]querypb.Type)
-	schemaQueries = map[string]*sqltypes.Result{
-		"select unix_timestamp()": {
-			Fields: []*querypb.Field{{
-				Type: sqltypes.Uint64,
-			}},
-			RowsAffected: 1,
-			Rows: [][]sqltypes.Value{
-				{sqltypes.NewInt32(1427325875)},
-			},
-		},
-		"select @@global.sql_mode": {
-			Fields: []*querypb.Field{{
-				Type: sqltypes.VarChar,
-			}},
-			RowsAffected: 1,
-			Rows: [][]sqltypes.Value{
-				{sqltypes.NewVarBinary("STRICT_TRANS_TABLES")},
-			},
-		},
-		"select @@autocommit": {
-			Fields: []*querypb.Field{{
-				Type: sqltypes.Uint64,
-			}},
-			RowsAffected: 1,
-			Rows: [][]sqltypes.Value{
-				{sqltypes.NewVarBinary("1")},
-			},
-		},
-		"show variables like 'binlog_format'": {
-			Fields: []*querypb.Field{{
-				Type: sqltypes.VarChar,
-			}, {
-				Type: sqltypes.VarChar,
-			}},
-			RowsAffected: 1,
-			Rows: [][]sqltypes.Value{{
-				sqltypes.NewVarBinary("binlog_format"),
-				sqltypes.NewVarBinary(opts.ReplicationMode),
-			}},
-		},
+// StreamExecute is part of the QueryService interface.
+func (t *explainTablet) StreamExecute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]*querypb.BindVariable, options *querypb.ExecuteOptions, callback func(*sqltypes.Result) error) error {
+	t.currentTime = t.queryTime(target)
+	bindVariables = sqltypes.CopyBindVariables(bindVariables)
+	tq, stmt := t.newTabletQuery(sql, bindVariables, 0)
+	t.tabletQueries = append(t.tabletQueries, tq)
+	if err := t.checkDeadline(ctx); err != nil {
+		return err
 	}
+	if err := t.env.bindVarTypeError(stmt, bindVariables); err != nil {
+		return err
+	}
+	defer t.simulateDuration(sql)
 
-	showTableRows := make([][]sqltypes.Value, 0, 4)
-	for _, ddl := range ddls {
-		table := ddl.NewName.Name.String()
-		showTableRows = append(showTableRows, mysql.BaseShowTablesRow(table, false, ""))
+	send := func(result *sqltypes.Result) error {
+		tq.Batches++
+		return callback(result)
 	}
-	schemaQueries[mysql.BaseShowTables] = &sqltypes.Result{
-		Fields:       mysql.BaseShowTablesFields,
-		RowsAffected: uint64(len(showTableRows)),
-		Rows:         showTableRows,
+	if t.env.streamBatchSize == 0 {
+		return t.tsv.StreamExecute(ctx, target, sql, bindVariables, options, send)
 	}
+	splitter := &resultSplitter{size: t.env.streamBatchSize, send: send}
+	if err := t.tsv.StreamExecute(ctx, target, sql, bindVariables, options, splitter.add); err != nil {
+		return err
+	}
+	return splitter.flush()
+}
 
-	for i, ddl := range ddls {
-		table := ddl.NewName.Name.String()
-		schemaQueries[mysql.BaseShowTablesForTable(table)] = &sqltypes.Result{
-			Fields:       mysql.BaseShowTablesFields,
-			RowsAffected: 1,
-			Rows:         [][]sqltypes.Value{showTableRows[i]},
-		}
-
-		pkColumns := make(map[string]bool)
-
-		indexRows := make([][]sqltypes.Value, 0, 4)
-		for _, idx := range ddl.TableSpec.Indexes {
-			for i, col := range idx.Columns {
-				row := mysql.ShowIndexFromTableRow(table, idx.Info.Unique, idx.Info.Name.String(), i+1, col.Column.String(), false)
-				indexRows = append(indexRows, row)
-				if idx.Info.Primary {
-					pkColumns[col.Column.String()] = true
-				}
-			}
-		}
+// resultSplitter sends the rows of the results of a streaming query in
+// results of a fixed number of rows. Only the first one has the fields.
+type resultSplitter struct {
+	size   int
+	send   func(*sqltypes.Result) error
+	fields []*querypb.Field
+	rows   [][]sqltypes.Value
+	sent   bool
+}
 
-		schemaQueries["show index from "+table] = &sqltypes.Result{
-			Fields:       mysql.ShowIndexFromTableFields,
-			RowsAffected: uint64(len(indexRows)),
-			Rows:         indexRows,
+// add buffers the rows of a result, and sends the full batches.
+func (s *resultSplitter) add(result *sqltypes.Result) error {
+	if result.Fields != nil {
+		s.fields = result.Fields
+	}
+	s.rows = append(s.rows, result.Rows...)
+	for len(s.rows) >= s.size {
+		if err := s.sendRows(s.size); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		describeTableRows := make([][]sqltypes.Value, 0, 4)
-		rowTypes := make([]*querypb.Field, 0, 4)
-		tableColumns[table] = make(map[string]querypb.Type)
-
-		for _, col := range ddl.TableSpec.Columns {
-			colName := col.Name.String()
-			defaultVal := ""
-			if col.Type.Default != nil {
-				defaultVal = sqlparser.String(col.Type.Default)
-			}
-			idxVal := ""
-			if pkColumns[colName] {
-				idxVal = "PRI"
-			}
-			row := mysql.DescribeTableRow(colName, col.Type.DescribeType(), !bool(col.Type.NotNull), idxVal, defaultVal)
-			describeTableRows = append(describeTableRows, row)
+// flush sends the remaining rows, or the fields if no result was sent.
+func (s *resultSplitter) flush() error {
+	if len(s.rows) == 0 && s.sent {
+		return nil
+	}
+	return s.sendRows(len(s.rows))
+}
 
-			rowType := &querypb.Field{
-				Name: colName,
-				Type: col.Type.SQLType(),
-			}
-			rowTypes = append(rowTypes, rowType)
+func (s *resultSplitter) sendRows(n int) error {
+	result := &sqltypes.Result{Rows: s.rows[:n:n]}
+	if !s.sent {
+		result.Fields = s.fields
+		s.sent = true
+	}
+	s.rows = s.rows[n:]
+	return s.send(result)
+}
 
-			tableColumns[table][colName] = col.Type.SQLType()
-		}
+// ExecuteBatch is part of the QueryService interface.
+func (t *explainTablet) ExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, asTransaction bool, transactionID int64, options *querypb.ExecuteOptions) ([]sqltypes.Result, error) {
+	if !asTransaction || transactionID != 0 {
+		t.currentTime = t.queryTime(target)
+		return t.executeBatch(ctx, target, queries, asTransaction, transactionID, options)
+	}
 
-		schemaQueries["describe "+table] = &sqltypes.Result{
-			Fields:       mysql.DescribeTableFields,
-			RowsAffected: uint64(len(describeTableRows)),
-			Rows:         describeTableRows,
+	// Run the batch in a transaction of its own, so that the begin and
+	// commit take their own logical time like the ones sent by vtgate.
+	transactionID, err := t.Begin(ctx, target, options)
+	if err != nil {
+		return nil, err
+	}
+	results, err := t.executeBatch(ctx, target, queries, false, transactionID, options)
+	if err != nil {
+		if rbErr := t.Rollback(ctx, target, transactionID); rbErr != nil {
+			log.Warningf("Rollback of batch transaction %d failed: %v", transactionID, rbErr)
+			return nil, vterrors.Aggregate([]error{err, rbErr})
 		}
+		return nil, err
+	}
+	if err := t.Commit(ctx, target, transactionID); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
 
-		schemaQueries["select * from "+table+" where 1 != 1"] = &sqltypes.Result{
-			Fields: rowTypes,
+// executeBatch records the queries of a batch at the current logical time
+// and runs them on the tabletserver.
+func (t *explainTablet) executeBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, asTransaction bool, transactionID int64, options *querypb.ExecuteOptions) ([]sqltypes.Result, error) {
+	boundQueries := make([]*querypb.BoundQuery, 0, len(queries))
+	stmts := make([]sqlparser.Statement, 0, len(queries))
+	for _, query := range queries {
+		bindVariables := sqltypes.CopyBindVariables(query.BindVariables)
+		tq, stmt := t.newTabletQuery(query.Sql, bindVariables, transactionID)
+		t.tabletQueries = append(t.tabletQueries, tq)
+		boundQueries = append(boundQueries, &querypb.BoundQuery{
+			Sql:           query.Sql,
+			BindVariables: bindVariables,
+		})
+		stmts = append(stmts, stmt)
+		defer t.simulateDuration(query.Sql)
+	}
+	if err := t.checkDeadline(ctx); err != nil {
+		return nil, err
+	}
+	for i, query := range boundQueries {
+		if err := t.env.bindVarTypeError(stmts[i], query.BindVariables); err != nil {
+			return nil, err
 		}
 	}
+	return t.tsv.ExecuteBatch(ctx, target, boundQueries, asTransaction, transactionID, options)
+}
 
-	return nil
+// newTabletQuery returns how a query sent to the tablet at the current
+// logical time is recorded, and its statement, which is nil if the query
+// can't be parsed. The query is only parsed once for all its details.
+func (t *explainTablet) newTabletQuery(sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64) (*TabletQuery, sqlparser.Statement) {
+	// the tabletserver reports any parse error
+	stmt, _ := sqlparser.Parse(sql)
+	return &TabletQuery{
+		Time:          t.currentTime,
+		SQL:           sql,
+		BindVars:      bindVariables,
+		ListSizes:     listSizes(bindVariables),
+		Lock:          queryLock(stmt),
+		Distinct:      isDistinct(stmt),
+		TransactionID: transactionID,
+		InsertValues:  t.env.insertValues(stmt, bindVariables),
+		BindVarTypes:  t.env.bindVarTypes(stmt),
+	}, stmt
 }
 
-// HandleQuery implements the fakesqldb query handler interface
-func (t *explainTablet) HandleQuery(c *mysql.Conn, query string, callback func(*sqltypes.Result) error) error {
-	if !strings.Contains(query, "1 != 1") {
-		t.mysqlQueries = append(t.mysqlQueries, &MysqlQuery{
-			Time: t.currentTime,
-			SQL:  query,
-		})
+// checkDeadline returns an error if the context of a query is done, or if
+// its deadline is closer than the simulated latency of the query.
+func (t *explainTablet) checkDeadline(ctx context.Context) error {
+	switch ctx.Err() {
+	case nil:
+	case context.Canceled:
+		return vterrors.New(vtrpcpb.Code_CANCELED, "context canceled")
+	default:
+		return vterrors.New(vtrpcpb.Code_DEADLINE_EXCEEDED, "context deadline exceeded")
 	}
-
-	// return the pre-computed results for any schema introspection queries
-	result, ok := schemaQueries[query]
-	if ok {
-		return callback(result)
+	if t.env.queryLatency == 0 {
+		return nil
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return nil
 	}
+	if remaining := time.Until(deadline); remaining < t.env.queryLatency {
+		return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "query takes %v, more than the %v left before its deadline", t.env.queryLatency, remaining)
+	}
+	return nil
+}
 
-	switch sqlparser.Preview(query) {
-	case sqlparser.StmtSelect:
-		// Parse the select statement to figure out the table and columns
-		// that were referenced so that the synthetic response has the
-		// expected field names and types.
-		stmt, err := sqlparser.Parse(query)
-		if err != nil {
-			return err
+// bindVarTypeError returns an error if bind variable type checks are
+// enabled and the where clause of the given select, update or delete
+// compares a column for equality with a bind variable that mysql would
+// have to convert, e.g. a string for an integer column. Columns that can't
+// be resolved to a single table of the schema are not checked.
+func (env *tabletEnv) bindVarTypeError(stmt sqlparser.Statement, bindVars map[string]*querypb.BindVariable) error {
+	if !env.bindVarTypeChecks {
+		return nil
+	}
+	for _, bvc := range env.bindVarColumns(stmt) {
+		bv := bindVars[bvc.name]
+		if bv == nil {
+			continue
 		}
-
-		selStmt := stmt.(*sqlparser.Select)
-
-		if len(selStmt.From) != 1 {
-			return fmt.Errorf("unsupported select with multiple from clauses")
+		colType := env.tableColumns[bvc.table][bvc.column]
+		if !bindVarTypeMatches(colType, bv.Type) {
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "bind variable %s of type %v doesn't match column %s.%s of type %v", bvc.name, bv.Type, bvc.table, bvc.column, colType)
 		}
+	}
+	return nil
+}
 
-		var table sqlparser.TableIdent
-		switch node := selStmt.From[0].(type) {
-		case *sqlparser.AliasedTableExpr:
-			table = sqlparser.GetTableName(node.Expr)
-			break
+// bindVarTypes returns the types of the columns that the where clause of
+// the given select, update or delete compares for equality with bind
+// variables, by bind variable name, or nil if there are none.
+func (env *tabletEnv) bindVarTypes(stmt sqlparser.Statement) BindVarTypes {
+	var types BindVarTypes
+	for _, bvc := range env.bindVarColumns(stmt) {
+		if types == nil {
+			types = make(BindVarTypes)
 		}
+		types[bvc.name] = env.tableColumns[bvc.table][bvc.column]
+	}
+	return types
+}
 
-		// For complex select queries just return an empty result
-		// since it's too hard to figure out the real columns
-		if table.IsEmpty() {
-			log.V(100).Infof("query %s result {}\n", query)
-			return callback(&sqltypes.Result{})
-		}
+// bindVarColumn is a bind variable that a query compares for equality with
+// a column of a schema table.
+type bindVarColumn struct {
+	name   string
+	table  string
+	column string
+}
+
+// bindVarColumns returns the bind variables that the where clause of the
+// given select, update or delete compares for equality with a column, in
+// the order they appear. Columns that can't be resolved to a single table
+// of the schema are skipped.
+func (env *tabletEnv) bindVarColumns(stmt sqlparser.Statement) []bindVarColumn {
+	var from sqlparser.TableExprs
+	var where *sqlparser.Where
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		from, where = stmt.From, stmt.Where
+	case *sqlparser.Update:
+		from, where = stmt.TableExprs, stmt.Where
+	case *sqlparser.Delete:
+		from, where = stmt.TableExprs, stmt.Where
+	}
+	if where == nil {
+		return nil
+	}
 
-		colTypeMap := tableColumns[table.String()]
-		if colTypeMap == nil {
-			return fmt.Errorf("unable to resolve table name %s", table.String())
+	// map of the name or alias of the tables of the query to their name
+	tables := make(map[string]string)
+	sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		expr, ok := node.(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return true, nil
+		}
+		if tableName, ok := expr.Expr.(sqlparser.TableName); ok && env.tableColumns[tableName.Name.String()] != nil {
+			alias := expr.As
+			if alias.IsEmpty() {
+				alias = tableName.Name
+			}
+			tables[alias.String()] = tableName.Name.String()
 		}
+		// the tables of derived tables aren't visible to the where clause
+		return false, nil
+	}, from)
 
-		colNames := make([]string, 0, 4)
-		colTypes := make([]querypb.Type, 0, 4)
-		for _, node := range selStmt.SelectExprs {
-			switch node := node.(type) {
-			case *sqlparser.AliasedExpr:
-				switch node := node.Expr.(type) {
-				case *sqlparser.ColName:
-					col := node.Name.String()
-					colType := colTypeMap[col]
-					if colType == querypb.Type_NULL_TYPE {
-						return fmt.Errorf("invalid column %s", col)
-					}
-					colNames = append(colNames, col)
-					colTypes = append(colTypes, colType)
-					break
-				case *sqlparser.FuncExpr:
-					// As a shortcut, functions are integral types
-					colNames = append(colNames, sqlparser.String(node))
-					colTypes = append(colTypes, querypb.Type_INT32)
-					break
-				case *sqlparser.SQLVal:
-					colNames = append(colNames, sqlparser.String(node))
-					switch node.Type {
-					case sqlparser.IntVal:
-						fallthrough
-					case sqlparser.HexNum:
-						fallthrough
-					case sqlparser.HexVal:
-						fallthrough
-					case sqlparser.BitVal:
-						colTypes = append(colTypes, querypb.Type_INT32)
-					case sqlparser.StrVal:
-						colTypes = append(colTypes, querypb.Type_VARCHAR)
-					case sqlparser.FloatVal:
-						colTypes = append(colTypes, querypb.Type_FLOAT64)
-					default:
-						return fmt.Errorf("unsupported sql value %s", sqlparser.String(node))
-					}
-					break
-				default:
-					return fmt.Errorf("unsupported select expression %s", sqlparser.String(node))
+	var bvcs []bindVarColumn
+	sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Subquery:
+			return false, nil
+		case *sqlparser.ComparisonExpr:
+			if node.Operator != sqlparser.EqualStr && node.Operator != sqlparser.NullSafeEqualStr {
+				return true, nil
+			}
+			col, ok := node.Left.(*sqlparser.ColName)
+			val, isVal := node.Right.(*sqlparser.SQLVal)
+			if !ok || !isVal {
+				col, ok = node.Right.(*sqlparser.ColName)
+				val, isVal = node.Left.(*sqlparser.SQLVal)
+			}
+			if !ok || !isVal || val.Type != sqlparser.ValArg {
+				return true, nil
+			}
+			if table := env.columnTable(tables, col); table != "" {
+				bvcs = append(bvcs, bindVarColumn{
+					name:   string(val.Val[1:]),
+					table:  table,
+					column: col.Name.String(),
+				})
+			}
+		}
+		return true, nil
+	}, where.Expr)
+	return bvcs
+}
+
+// columnTable returns the name of the table of the given column, given the
+// tables of the query by name or alias, or "" if it isn't a column of
+// exactly one of them.
+func (env *tabletEnv) columnTable(tables map[string]string, col *sqlparser.ColName) string {
+	if !col.Qualifier.IsEmpty() {
+		table := tables[col.Qualifier.Name.String()]
+		if _, ok := env.tableColumns[table][col.Name.String()]; !ok {
+			return ""
+		}
+		return table
+	}
+	found := ""
+	for _, table := range tables {
+		if _, ok := env.tableColumns[table][col.Name.String()]; ok {
+			if found != "" {
+				return ""
+			}
+			found = table
+		}
+	}
+	return found
+}
+
+// bindVarTypeMatches returns false if mysql would have to convert between a
+// number and a string to compare a column of type colType with a bind
+// variable of type bvType.
+func bindVarTypeMatches(colType, bvType querypb.Type) bool {
+	isNumber := func(typ querypb.Type) bool {
+		return sqltypes.IsIntegral(typ) || sqltypes.IsFloat(typ) || typ == sqltypes.Decimal
+	}
+	switch {
+	case bvType == sqltypes.Null:
+		return true
+	case isNumber(colType):
+		return isNumber(bvType)
+	case sqltypes.IsText(colType) || sqltypes.IsBinary(colType):
+		return sqltypes.IsQuoted(bvType)
+	}
+	// other types, e.g. temporal ones, are compared with numbers and
+	// strings alike
+	return true
+}
+
+// MessageStream is part of the QueryService interface. The simulated
+// tablets don't run a message manager, so the call is only recorded and the
+// stream ends without any message.
+func (t *explainTablet) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error {
+	t.currentTime = t.env.batchTime.Wait()
+	t.tabletQueries = append(t.tabletQueries, &TabletQuery{
+		Time: t.currentTime,
+		SQL:  "message stream " + name,
+	})
+	return nil
+}
+
+// MessageAck is part of the QueryService interface. The call is recorded
+// with the acked ids as the "ids" list bind variable, and no message is
+// acked.
+func (t *explainTablet) MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (int64, error) {
+	t.currentTime = t.env.batchTime.Wait()
+	bindVariables := map[string]*querypb.BindVariable{
+		"ids": {
+			Type:   querypb.Type_TUPLE,
+			Values: ids,
+		},
+	}
+	t.tabletQueries = append(t.tabletQueries, &TabletQuery{
+		Time:      t.currentTime,
+		SQL:       "message ack " + name,
+		BindVars:  bindVariables,
+		ListSizes: listSizes(bindVariables),
+	})
+	return 0, nil
+}
+
+// queryLock returns the locking clause of a select, i.e. "for update" or
+// "lock in share mode", or an empty string for a non-locking read or any
+// other kind of query.
+func queryLock(stmt sqlparser.Statement) string {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		return strings.TrimSpace(stmt.Lock)
+	case *sqlparser.Union:
+		return strings.TrimSpace(stmt.Lock)
+	}
+	return ""
+}
+
+// isDistinct returns true if the query is a select distinct.
+func isDistinct(stmt sqlparser.Statement) bool {
+	sel, ok := stmt.(*sqlparser.Select)
+	return ok && sel.Distinct != ""
+}
+
+// insertValues returns the values of each row written by an insert or
+// replace, keyed by column and rendered as sql literals, with the given
+// bind variables substituted. Columns that aren't named in the statement
+// or the schema are keyed by their position. It returns nil unless
+// recording insert values is enabled.
+func (env *tabletEnv) insertValues(stmt sqlparser.Statement, bindVars map[string]*querypb.BindVariable) []map[string]string {
+	if !env.recordInsertValues {
+		return nil
+	}
+	insert, ok := stmt.(*sqlparser.Insert)
+	if !ok {
+		return nil
+	}
+	rows, ok := insert.Rows.(sqlparser.Values)
+	if !ok {
+		return nil
+	}
+
+	columns := make([]string, 0, len(insert.Columns))
+	for _, col := range insert.Columns {
+		columns = append(columns, col.String())
+	}
+	if len(columns) == 0 {
+		columns = env.tableColumnNames[insert.Table.Name.String()]
+	}
+
+	values := make([]map[string]string, 0, len(rows))
+	for _, row := range rows {
+		rowValues := make(map[string]string, len(row))
+		for i, expr := range row {
+			col := strconv.Itoa(i + 1)
+			if i < len(columns) {
+				col = columns[i]
+			}
+			rowValues[col] = insertValue(expr, bindVars)
+		}
+		values = append(values, rowValues)
+	}
+	return values
+}
+
+// insertValue renders a value of an insert as a sql literal, substituting
+// the bind variable it refers to if there is one.
+func insertValue(expr sqlparser.Expr, bindVars map[string]*querypb.BindVariable) string {
+	if val, ok := expr.(*sqlparser.SQLVal); ok && val.Type == sqlparser.ValArg {
+		if bv, ok := bindVars[string(val.Val[1:])]; ok {
+			if v, err := sqltypes.BindVariableToValue(bv); err == nil {
+				var b bytes.Buffer
+				v.EncodeSQL(&b)
+				return b.String()
+			}
+		}
+	}
+	return sqlparser.String(expr)
+}
+
+// simulateDuration blocks for any additional logical time units configured
+// for the kind of the given statement, so that queries issued after it
+// completes are placed correspondingly later in the simulated timeline.
+func (t *explainTablet) simulateDuration(sql string) {
+	duration := t.env.statementDurations[statementKinds[sqlparser.Preview(sql)]]
+	for i := 1; i < duration; i++ {
+		t.env.batchTime.Wait()
+	}
+}
+
+// Close is part of the QueryService interface.
+func (t *explainTablet) Close(ctx context.Context) error {
+	return t.tsv.Close(ctx)
+}
+
+// tableAccesses returns the tables that the given query reads and the ones
+// that it writes, with a table listed once for each time it is referenced.
+// Queries that can't be parsed don't access any table.
+func tableAccesses(sql string) (reads, writes []string) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, nil
+	}
+	switch stmt := stmt.(type) {
+	case *sqlparser.Insert:
+		writes = []string{stmt.Table.Name.String()}
+		reads = tableExprNames(stmt.Rows)
+	case *sqlparser.Update:
+		writes = tableExprNames(stmt.TableExprs)
+		reads = tableExprNames(stmt.Exprs, stmt.Where)
+	case *sqlparser.Delete:
+		if len(stmt.Targets) == 0 {
+			writes = tableExprNames(stmt.TableExprs)
+			reads = tableExprNames(stmt.Where)
+			break
+		}
+		// a multi-table delete only writes its targets and reads the
+		// other tables it joins with
+		targets := make(map[string]bool)
+		for _, target := range stmt.Targets {
+			writes = append(writes, target.Name.String())
+			targets[target.Name.String()] = true
+		}
+		for _, table := range tableExprNames(stmt.TableExprs, stmt.Where) {
+			if !targets[table] {
+				reads = append(reads, table)
+			}
+		}
+	default:
+		reads = tableExprNames(stmt)
+	}
+	return reads, writes
+}
+
+// tableExprNames returns the names of the tables referenced in the from
+// clauses of the given nodes.
+func tableExprNames(nodes ...sqlparser.SQLNode) []string {
+	var names []string
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if node, ok := node.(*sqlparser.AliasedTableExpr); ok {
+			if table := sqlparser.GetTableName(node.Expr); !table.IsEmpty() {
+				names = append(names, table.String())
+			}
+		}
+		return true, nil
+	}, nodes...)
+	return names
+}
+
+// newKeyspaceEnvironments returns the environment for the tables of the
+// schema that aren't qualified by a keyspace, which is used by the tablets
+// of the keyspaces that have no table of their own, and the environment of
+// each keyspace that has qualified tables, e.g. "create table ks.t1". A
+// keyspace environment also has the unqualified tables, unless it has a
+// table of the same name, so the same table can have a different schema in
+// each keyspace.
+func newKeyspaceEnvironments(ddls []*sqlparser.DDL, opts *Options) (*tabletEnv, map[string]*tabletEnv, error) {
+	var sharedDDLs []*sqlparser.DDL
+	keyspaceDDLs := make(map[string][]*sqlparser.DDL)
+	for _, ddl := range ddls {
+		keyspace := ddl.NewName.Qualifier.String()
+		if keyspace == "" {
+			sharedDDLs = append(sharedDDLs, ddl)
+			continue
+		}
+		// The simulated mysql of a keyspace only knows the table name
+		unqualified := *ddl
+		unqualified.NewName = sqlparser.TableName{Name: ddl.NewName.Name}
+		keyspaceDDLs[keyspace] = append(keyspaceDDLs[keyspace], &unqualified)
+	}
+
+	// The per-column options go to the environments that have the
+	// column, and those of unknown columns to the shared one, which
+	// rejects them.
+	allColumns := ddlColumns(ddls)
+	sharedColumns := ddlColumns(sharedDDLs)
+	env, err := newTabletEnvironment(sharedDDLs, columnOptions(opts, func(key string) bool {
+		return sharedColumns[key] || !allColumns[key]
+	}))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyspaceEnvs := make(map[string]*tabletEnv)
+	for keyspace, ddls := range keyspaceDDLs {
+		tables := make(map[string]bool)
+		for _, ddl := range ddls {
+			tables[ddl.NewName.Name.String()] = true
+		}
+		for _, ddl := range sharedDDLs {
+			if !tables[ddl.NewName.Name.String()] {
+				ddls = append(ddls, ddl)
+			}
+		}
+		columns := ddlColumns(ddls)
+		keyspaceEnv, err := newTabletEnvironment(ddls, columnOptions(opts, func(key string) bool {
+			return columns[key]
+		}))
+		if err != nil {
+			return nil, nil, fmt.Errorf("keyspace %s: %v", keyspace, err)
+		}
+		keyspaceEnvs[keyspace] = keyspaceEnv
+	}
+	return env, keyspaceEnvs, nil
+}
+
+// ddlColumns returns the set of "table.column" names of the columns of the
+// given create table statements, along with the names of the tables.
+func ddlColumns(ddls []*sqlparser.DDL) map[string]bool {
+	columns := make(map[string]bool)
+	for _, ddl := range ddls {
+		columns[ddl.NewName.Name.String()] = true
+		for _, col := range ddl.TableSpec.Columns {
+			columns[ddl.NewName.Name.String()+"."+col.Name.String()] = true
+		}
+	}
+	return columns
+}
+
+// columnOptions returns a copy of opts with only the per-table and
+// per-column options whose "table" or "table.column" key is accepted by
+// keep.
+func columnOptions(opts *Options, keep func(key string) bool) *Options {
+	filtered := *opts
+	filtered.ColumnValues = make(map[string][]string)
+	for key, values := range opts.ColumnValues {
+		if keep(key) {
+			filtered.ColumnValues[key] = values
+		}
+	}
+	filtered.ColumnTypeOverrides = make(map[string]querypb.Type)
+	for key, typ := range opts.ColumnTypeOverrides {
+		if keep(key) {
+			filtered.ColumnTypeOverrides[key] = typ
+		}
+	}
+	filtered.Collations = make(map[string]string)
+	for key, collation := range opts.Collations {
+		if keep(key) {
+			filtered.Collations[key] = collation
+		}
+	}
+	return &filtered
+}
+
+func newTabletEnvironment(ddls []*sqlparser.DDL, opts *Options) (*tabletEnv, error) {
+	if err := validateSchema(ddls); err != nil {
+		return nil, err
+	}
+
+	env := &tabletEnv{}
+
+	env.tableColumns = make(map[string]map[string]querypb.Type)
+	env.tableColumnDefs = make(map[string]map[string]*sqlparser.ColumnType)
+	env.tableColumnNames = make(map[string][]string)
+	env.tableAutoIncrement = make(map[string]string)
+	env.tablePKColumns = make(map[string][]string)
+	env.tableIndexes = make(map[string][][]string)
+	env.tableIndexInfos = make(map[string][]*sqlparser.IndexInfo)
+	env.tableCollations = make(map[string]string)
+	env.columnCollations = make(map[string]map[string]string)
+	env.tableRowCounts = opts.RowsPerTable
+
+	for kind, duration := range opts.StatementDurations {
+		if !sqlparser.StringIn(kind, "select", "insert", "replace", "update", "delete") {
+			return nil, fmt.Errorf("invalid statement kind %s", kind)
+		}
+		if duration < 1 {
+			return nil, fmt.Errorf("invalid duration %d for statement kind %s", duration, kind)
+		}
+	}
+	env.statementDurations = opts.StatementDurations
+	for shard, latency := range opts.ShardLatencies {
+		if latency.Base < 0 || latency.Jitter < 0 {
+			return nil, fmt.Errorf("invalid latency %+v for shard %s", latency, shard)
+		}
+	}
+	env.shardLatencies = opts.ShardLatencies
+	env.latencySeed = opts.LatencySeed
+	env.multiStatements = opts.MultiStatements
+	if opts.StreamBatchSize < 0 {
+		return nil, fmt.Errorf("invalid stream batch size %d", opts.StreamBatchSize)
+	}
+	env.streamBatchSize = opts.StreamBatchSize
+	env.replaceConflict = opts.ReplaceConflict
+	env.duplicateKeyConflict = opts.DuplicateKeyConflict
+	env.recordInsertValues = opts.RecordInsertValues
+	env.valueGenerator = opts.ValueGenerator
+	env.queryHook = opts.QueryHook
+	env.strictNotNull = opts.StrictNotNull
+	env.bindVarTypeChecks = opts.CheckBindVarTypes
+	env.queryLatency = opts.QueryLatency
+
+	// Sort the patterns so that the first match is deterministic
+	patterns := make([]string, 0, len(opts.ErrorQueries))
+	for pattern := range opts.ErrorQueries {
+		patterns = append(patterns, pattern)
+	}
+	sort.Strings(patterns)
+	env.errorQueries = make([]*errorQuery, 0, len(patterns))
+	for _, pattern := range patterns {
+		expr, err := regexp.Compile("^" + pattern + "$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid error query pattern %s: %v", pattern, err)
+		}
+		env.errorQueries = append(env.errorQueries, &errorQuery{
+			expr: expr,
+			err:  mysql.NewSQLError(mysql.ERUnknownError, mysql.SSUnknownSQLState, "%s", opts.ErrorQueries[pattern]),
+		})
+	}
+	env.defaultRowCount = opts.NumRows
+	if env.defaultRowCount == 0 {
+		env.defaultRowCount = 1
+	}
+	env.sqlMode = defaultSQLMode
+	if opts.SQLMode != "" {
+		env.sqlMode = opts.SQLMode
+	}
+	env.mysqlVersion = defaultMysqlVersion
+	if opts.MysqlVersion != "" {
+		env.mysqlVersion = opts.MysqlVersion
+	}
+	env.unixTimestamp = defaultUnixTimestamp
+	env.baseTime = defaultBaseTime
+	if opts.UnixTimestamp != 0 {
+		env.unixTimestamp = opts.UnixTimestamp
+		env.baseTime = time.Unix(opts.UnixTimestamp, 0).UTC()
+	}
+	env.timeZone = defaultTimeZone
+	if opts.TimeZone != "" {
+		env.timeZone = opts.TimeZone
+	}
+	location, err := timeZoneLocation(env.timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone %s: %v", env.timeZone, err)
+	}
+	env.baseTime = env.baseTime.In(location)
+	env.schemaQueries = map[string]*sqltypes.Result{
+		"select unix_timestamp()": {
+			Fields: []*querypb.Field{{
+				Type: sqltypes.Uint64,
+			}},
+			RowsAffected: 1,
+			Rows: [][]sqltypes.Value{
+				{sqltypes.NewInt64(env.unixTimestamp)},
+			},
+		},
+		"select @@global.sql_mode": stringVarResult(env.sqlMode),
+		// The session sql_mode starts with the global value
+		"select @@sql_mode":         stringVarResult(env.sqlMode),
+		"select @@session.sql_mode": stringVarResult(env.sqlMode),
+		// Only the session time_zone is configured
+		"select @@global.time_zone":  stringVarResult(defaultTimeZone),
+		"select @@time_zone":         stringVarResult(env.timeZone),
+		"select @@session.time_zone": stringVarResult(env.timeZone),
+		"select @@version":           stringVarResult(env.mysqlVersion),
+		"select @@global.version":    stringVarResult(env.mysqlVersion),
+		"select version()":           stringVarResult(env.mysqlVersion),
+		"select @@autocommit": {
+			Fields: []*querypb.Field{{
+				Type: sqltypes.Uint64,
+			}},
+			RowsAffected: 1,
+			Rows: [][]sqltypes.Value{
+				{sqltypes.NewVarBinary("1")},
+			},
+		},
+		"show variables like 'binlog_format'": {
+			Fields: []*querypb.Field{{
+				Type: sqltypes.VarChar,
+			}, {
+				Type: sqltypes.VarChar,
+			}},
+			RowsAffected: 1,
+			Rows: [][]sqltypes.Value{{
+				sqltypes.NewVarBinary("binlog_format"),
+				sqltypes.NewVarBinary(opts.ReplicationMode),
+			}},
+		},
+	}
+
+	env.infoSchemaTables = map[string]*sqltypes.Result{
+		"columns": {Fields: infoSchemaColumnsFields},
+		"tables":  {Fields: infoSchemaTablesFields},
+
+		"key_column_usage": {Fields: infoSchemaKeyColumnUsageFields},
+	}
+
+	showTableRows := make([][]sqltypes.Value, 0, 4)
+	for _, ddl := range ddls {
+		table := ddl.NewName.Name.String()
+		showTableRows = append(showTableRows, mysql.BaseShowTablesRow(table, false, ""))
+		env.infoSchemaTables["tables"].Rows = append(env.infoSchemaTables["tables"].Rows, env.infoSchemaTablesRow(table))
+	}
+	env.schemaQueries[mysql.BaseShowTables] = &sqltypes.Result{
+		Fields:       mysql.BaseShowTablesFields,
+		RowsAffected: uint64(len(showTableRows)),
+		Rows:         showTableRows,
+	}
+
+	for i, ddl := range ddls {
+		table := ddl.NewName.Name.String()
+		env.schemaQueries[mysql.BaseShowTablesForTable(table)] = &sqltypes.Result{
+			Fields:       mysql.BaseShowTablesFields,
+			RowsAffected: 1,
+			Rows:         [][]sqltypes.Value{showTableRows[i]},
+		}
+
+		pkColumns := make(map[string]bool)
+
+		indexRows := make([][]sqltypes.Value, 0, 4)
+		for _, idx := range ddl.TableSpec.Indexes {
+			indexColumns := make([]string, 0, len(idx.Columns))
+			for _, col := range idx.Columns {
+				indexColumns = append(indexColumns, col.Column.Lowered())
+			}
+			env.tableIndexes[table] = append(env.tableIndexes[table], indexColumns)
+			env.tableIndexInfos[table] = append(env.tableIndexInfos[table], idx.Info)
+
+			// Seq_in_index follows the declared order of the key parts,
+			// which the schema engine uses to order multi-column keys.
+			for seq, col := range idx.Columns {
+				row := mysql.ShowIndexFromTableRow(table, idx.Info.Unique, idx.Info.Name.String(), seq+1, col.Column.String(), false)
+				indexRows = append(indexRows, row)
+				if idx.Info.Primary {
+					pkColumns[col.Column.String()] = true
+					env.tablePKColumns[table] = append(env.tablePKColumns[table], col.Column.String())
+				}
+				if idx.Info.Unique {
+					env.infoSchemaTables["key_column_usage"].Rows = append(env.infoSchemaTables["key_column_usage"].Rows,
+						infoSchemaKeyColumnUsageRow(idx.Info.Name.String(), table, col.Column.String(), seq+1, nil, 0))
+				}
+			}
+		}
+		for n, fk := range ddl.TableSpec.ForeignKeys {
+			// unnamed foreign keys get the same names as in mysql
+			name := fk.Name.String()
+			if name == "" {
+				name = fmt.Sprintf("%s_ibfk_%d", table, n+1)
+			}
+			for j, col := range fk.Source {
+				env.infoSchemaTables["key_column_usage"].Rows = append(env.infoSchemaTables["key_column_usage"].Rows,
+					infoSchemaKeyColumnUsageRow(name, table, col.String(), j+1, fk, j))
+			}
+		}
+
+		env.schemaQueries["show create table "+table] = &sqltypes.Result{
+			Fields:       showCreateTableFields,
+			RowsAffected: 1,
+			Rows: [][]sqltypes.Value{{
+				sqltypes.NewVarChar(table),
+				sqltypes.NewVarChar(sqlparser.String(ddl)),
+			}},
+		}
+
+		env.schemaQueries["show index from "+table] = &sqltypes.Result{
+			Fields:       mysql.ShowIndexFromTableFields,
+			RowsAffected: uint64(len(indexRows)),
+			Rows:         indexRows,
+		}
+
+		tableCharset, tableCollation := parseTableCharset(ddl.TableSpec.Options)
+		if collation, ok := opts.Collations[table]; ok {
+			tableCharset, tableCollation = resolveCollation("", collation, tableCharset, tableCollation)
+		}
+		env.tableCollations[table] = tableCollation
+		env.columnCollations[table] = make(map[string]string)
+
+		describeTableRows := make([][]sqltypes.Value, 0, 4)
+		fullColumnsRows := make([][]sqltypes.Value, 0, 4)
+		rowTypes := make([]*querypb.Field, 0, 4)
+		env.tableColumns[table] = make(map[string]querypb.Type)
+		env.tableColumnDefs[table] = make(map[string]*sqlparser.ColumnType)
+
+		for i, col := range ddl.TableSpec.Columns {
+			colName := col.Name.String()
+			defaultVal := ""
+			if col.Type.Default != nil {
+				defaultVal = sqlparser.String(col.Type.Default)
+			}
+			idxVal := ""
+			if pkColumns[colName] {
+				idxVal = "PRI"
+			}
+			row := mysql.DescribeTableRow(colName, col.Type.DescribeType(), !bool(col.Type.NotNull), idxVal, defaultVal)
+			if genOpt := col.Type.GeneratedOption(); genOpt != "" {
+				row[5] = sqltypes.NewVarChar(strings.ToUpper(genOpt) + " GENERATED")
+			}
+			describeTableRows = append(describeTableRows, row)
+
+			colType := col.Type.SQLType()
+			if override, ok := opts.ColumnTypeOverrides[table+"."+colName]; ok {
+				colType = override
+			}
+
+			var charset, collation string
+			if hasCollation(colType) {
+				charset, collation = resolveCollation(col.Type.Charset, col.Type.Collate, tableCharset, tableCollation)
+				if override, ok := opts.Collations[table+"."+colName]; ok {
+					charset, collation = resolveCollation("", override, charset, collation)
+				}
+				env.columnCollations[table][colName] = collation
+			}
+			fullColumnsRows = append(fullColumnsRows, showFullColumnsRow(row, collation))
+
+			rowType := &querypb.Field{
+				Name: colName,
+				Type: colType,
+			}
+			rowTypes = append(rowTypes, rowType)
+
+			env.tableColumns[table][colName] = colType
+
+			// Primary key columns are implicitly not null in mysql
+			colDef := col.Type
+			if pkColumns[colName] {
+				colDef.NotNull = true
+			}
+			env.tableColumnDefs[table][colName] = &colDef
+			env.tableColumnNames[table] = append(env.tableColumnNames[table], colName)
+			env.infoSchemaTables["columns"].Rows = append(env.infoSchemaTables["columns"].Rows, infoSchemaColumnsRow(table, colName, i+1, &colDef, idxVal, charset, collation))
+
+			if col.Type.Autoincrement {
+				env.tableAutoIncrement[table] = colName
+			}
+		}
+
+		env.schemaQueries["describe "+table] = &sqltypes.Result{
+			Fields:       mysql.DescribeTableFields,
+			RowsAffected: uint64(len(describeTableRows)),
+			Rows:         describeTableRows,
+		}
+
+		env.schemaQueries["show full columns from "+table] = &sqltypes.Result{
+			Fields:       showFullColumnsFields,
+			RowsAffected: uint64(len(fullColumnsRows)),
+			Rows:         fullColumnsRows,
+		}
+
+		env.schemaQueries["select * from "+table+" where 1 != 1"] = &sqltypes.Result{
+			Fields: rowTypes,
+		}
+	}
+
+	for key := range opts.ColumnTypeOverrides {
+		parts := strings.SplitN(key, ".", 2)
+		if len(parts) != 2 || env.tableColumnDefs[parts[0]][parts[1]] == nil {
+			return nil, fmt.Errorf("invalid column type override key %s: must be an existing table.column", key)
+		}
+	}
+
+	for key, collation := range opts.Collations {
+		parts := strings.SplitN(key, ".", 2)
+		if env.tableColumns[parts[0]] == nil || (len(parts) == 2 && env.columnCollations[parts[0]][parts[1]] == "") {
+			return nil, fmt.Errorf("invalid collation key %s: must be an existing table or table.column of a text column", key)
+		}
+		if collation == "" {
+			return nil, fmt.Errorf("invalid collation for %s: must not be empty", key)
+		}
+	}
+
+	env.columnValues = make(map[*sqlparser.ColumnType][]string)
+	for key, values := range opts.ColumnValues {
+		parts := strings.SplitN(key, ".", 2)
+		if len(parts) != 2 || env.tableColumnDefs[parts[0]][parts[1]] == nil {
+			return nil, fmt.Errorf("invalid column values key %s: must be an existing table.column", key)
+		}
+		colDef := env.tableColumnDefs[parts[0]][parts[1]]
+		colType := env.tableColumns[parts[0]][parts[1]]
+		for _, value := range values {
+			if _, err := sqltypes.NewValue(colType, []byte(value)); err != nil {
+				return nil, fmt.Errorf("invalid value %s for column %s: %v", value, key, err)
+			}
+		}
+		env.columnValues[colDef] = values
+	}
+
+	tableStatusRows := make([][]sqltypes.Value, 0, len(ddls))
+	for _, ddl := range ddls {
+		table := ddl.NewName.Name.String()
+		row := env.showTableStatusRow(table)
+		tableStatusRows = append(tableStatusRows, row)
+		env.schemaQueries["show table status like '"+table+"'"] = &sqltypes.Result{
+			Fields:       showTableStatusFields,
+			RowsAffected: 1,
+			Rows:         [][]sqltypes.Value{row},
+		}
+	}
+	env.schemaQueries["show table status"] = &sqltypes.Result{
+		Fields:       showTableStatusFields,
+		RowsAffected: uint64(len(tableStatusRows)),
+		Rows:         tableStatusRows,
+	}
+
+	return env, nil
+}
+
+// schemaTable is the json representation of a table of the schema, as
+// interpreted by vtexplain
+type schemaTable struct {
+	Name    string
+	Columns []*schemaColumn
+	Indexes []*schemaIndex `json:",omitempty"`
+}
+
+type schemaColumn struct {
+	Name          string
+	Type          string
+	ColumnType    string
+	Nullable      bool
+	AutoIncrement bool `json:",omitempty"`
+}
+
+type schemaIndex struct {
+	Name    string
+	Columns []string
+	Primary bool `json:",omitempty"`
+	Unique  bool `json:",omitempty"`
+}
+
+// schemaTables returns the tables of the environment, ordered by name, with
+// their columns and indexes in the order of their definition.
+func (env *tabletEnv) schemaTables() []*schemaTable {
+	names := make([]string, 0, len(env.tableColumnNames))
+	for name := range env.tableColumnNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	tables := make([]*schemaTable, 0, len(names))
+	for _, name := range names {
+		table := &schemaTable{Name: name}
+		for _, colName := range env.tableColumnNames[name] {
+			colDef := env.tableColumnDefs[name][colName]
+			table.Columns = append(table.Columns, &schemaColumn{
+				Name:          colName,
+				Type:          env.tableColumns[name][colName].String(),
+				ColumnType:    colDef.DescribeType(),
+				Nullable:      !bool(colDef.NotNull),
+				AutoIncrement: bool(colDef.Autoincrement),
+			})
+		}
+		for i, info := range env.tableIndexInfos[name] {
+			table.Indexes = append(table.Indexes, &schemaIndex{
+				Name:    info.Name.String(),
+				Columns: env.tableIndexes[name][i],
+				Primary: info.Primary,
+				Unique:  info.Unique,
+			})
+		}
+		tables = append(tables, table)
+	}
+	return tables
+}
+
+// fullScanTables returns the tables that the given select is likely to
+// scan entirely, because its where clause and join conditions don't
+// constrain the leading column of any of their indexes. It returns nil
+// for other statements.
+func (env *tabletEnv) fullScanTables(sql string) []string {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil
+	}
+
+	var constrained []*sqlparser.ColName
+	var tables []sqlparser.TableIdent
+	var names []string
+	var visitTableExpr func(expr sqlparser.TableExpr)
+	visitTableExpr = func(expr sqlparser.TableExpr) {
+		switch expr := expr.(type) {
+		case *sqlparser.AliasedTableExpr:
+			tableName, ok := expr.Expr.(sqlparser.TableName)
+			if !ok || env.tableColumns[tableName.Name.String()] == nil {
+				return
+			}
+			alias := expr.As
+			if alias.IsEmpty() {
+				alias = tableName.Name
+			}
+			tables = append(tables, alias)
+			names = append(names, tableName.Name.String())
+		case *sqlparser.JoinTableExpr:
+			visitTableExpr(expr.LeftExpr)
+			visitTableExpr(expr.RightExpr)
+			constrained = append(constrained, constrainedColumns(expr.On)...)
+		case *sqlparser.ParenTableExpr:
+			for _, e := range expr.Exprs {
+				visitTableExpr(e)
+			}
+		}
+	}
+	for _, expr := range sel.From {
+		visitTableExpr(expr)
+	}
+	if sel.Where != nil {
+		constrained = append(constrained, constrainedColumns(sel.Where.Expr)...)
+	}
+
+	var result []string
+	for i, table := range names {
+		if !env.indexConstrained(table, tables[i], constrained) {
+			result = append(result, table)
+		}
+	}
+	return result
+}
+
+// indexConstrained returns true if one of the given columns is the
+// leading column of an index of the table
+func (env *tabletEnv) indexConstrained(table string, alias sqlparser.TableIdent, columns []*sqlparser.ColName) bool {
+	for _, col := range columns {
+		if !col.Qualifier.IsEmpty() && col.Qualifier.Name.String() != alias.String() {
+			continue
+		}
+		for _, index := range env.tableIndexes[table] {
+			if index[0] == col.Name.Lowered() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// constrainedColumns returns the columns of an expression that are
+// compared in a way that an index can be used for, in the conjuncts of
+// the expression. Columns under an or are ignored since all sides would
+// need to be constrained.
+func constrainedColumns(expr sqlparser.Expr) []*sqlparser.ColName {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		return append(constrainedColumns(expr.Left), constrainedColumns(expr.Right)...)
+	case *sqlparser.ParenExpr:
+		return constrainedColumns(expr.Expr)
+	case *sqlparser.ComparisonExpr:
+		switch expr.Operator {
+		case sqlparser.NotEqualStr, sqlparser.NotInStr, sqlparser.NotLikeStr, sqlparser.RegexpStr, sqlparser.NotRegexpStr:
+			return nil
+		}
+		var columns []*sqlparser.ColName
+		if col, ok := expr.Left.(*sqlparser.ColName); ok {
+			columns = append(columns, col)
+		}
+		if col, ok := expr.Right.(*sqlparser.ColName); ok {
+			columns = append(columns, col)
+		}
+		return columns
+	case *sqlparser.RangeCond:
+		if col, ok := expr.Left.(*sqlparser.ColName); ok && expr.Operator == sqlparser.BetweenStr {
+			return []*sqlparser.ColName{col}
+		}
+	case *sqlparser.IsExpr:
+		// mysql looks up null values in indexes, but not non-null ones
+		if col, ok := expr.Expr.(*sqlparser.ColName); ok && expr.Operator == sqlparser.IsNullStr {
+			return []*sqlparser.ColName{col}
+		}
+	}
+	return nil
+}
+
+// validateSchema checks that every index in the given create table
+// statements only references columns of its table. All problems are
+// reported together so that they can be fixed in one pass.
+func validateSchema(ddls []*sqlparser.DDL) error {
+	var problems []string
+	for _, ddl := range ddls {
+		table := ddl.NewName.Name.String()
+		columns := make(map[string]bool)
+		for _, col := range ddl.TableSpec.Columns {
+			columns[col.Name.Lowered()] = true
+		}
+		for _, idx := range ddl.TableSpec.Indexes {
+			for _, col := range idx.Columns {
+				if !columns[col.Column.Lowered()] {
+					problems = append(problems, fmt.Sprintf("index %s on table %s references unknown column %s", idx.Info.Name.String(), table, col.Column.String()))
+				}
+			}
+		}
+		for _, fk := range ddl.TableSpec.ForeignKeys {
+			for _, col := range fk.Source {
+				if !columns[col.Lowered()] {
+					problems = append(problems, fmt.Sprintf("foreign key on table %s references unknown column %s", table, col.String()))
+				}
+			}
+			if len(fk.Source) != len(fk.ReferencedColumns) {
+				problems = append(problems, fmt.Sprintf("foreign key on table %s references %d columns of table %s for %d columns", table, len(fk.ReferencedColumns), fk.ReferencedTable.Name.String(), len(fk.Source)))
+			}
+		}
+	}
+	if len(problems) != 0 {
+		return fmt.Errorf("invalid schema: %s", strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+// infoSchemaDBName is the database name reported by the simulated
+// information_schema tables.
+const infoSchemaDBName = "vt_explain"
+
+// infoSchemaColumnsFields contains the subset of the information_schema.columns
+// fields that are supported by the simulated tablet.
+var infoSchemaColumnsFields = []*querypb.Field{
+	{Name: "TABLE_SCHEMA", Type: sqltypes.VarChar},
+	{Name: "TABLE_NAME", Type: sqltypes.VarChar},
+	{Name: "COLUMN_NAME", Type: sqltypes.VarChar},
+	{Name: "ORDINAL_POSITION", Type: sqltypes.Uint64},
+	{Name: "COLUMN_DEFAULT", Type: sqltypes.Text},
+	{Name: "IS_NULLABLE", Type: sqltypes.VarChar},
+	{Name: "DATA_TYPE", Type: sqltypes.VarChar},
+	{Name: "COLUMN_TYPE", Type: sqltypes.Text},
+	{Name: "COLUMN_KEY", Type: sqltypes.VarChar},
+	{Name: "CHARACTER_SET_NAME", Type: sqltypes.VarChar},
+	{Name: "COLLATION_NAME", Type: sqltypes.VarChar},
+}
+
+// infoSchemaColumnsRow returns the information_schema.columns row for the
+// given column. The charset and collation are empty for columns that
+// don't have any.
+func infoSchemaColumnsRow(table, colName string, position int, colDef *sqlparser.ColumnType, key, charset, collation string) []sqltypes.Value {
+	nullable := "YES"
+	if colDef.NotNull {
+		nullable = "NO"
+	}
+	defaultVal := sqltypes.NULL
+	if colDef.Default != nil && colDef.Default.Type != sqlparser.ValArg {
+		defaultVal = sqltypes.MakeTrusted(sqltypes.Text, colDef.Default.Val)
+	}
+	return []sqltypes.Value{
+		sqltypes.NewVarChar(infoSchemaDBName),
+		sqltypes.NewVarChar(table),
+		sqltypes.NewVarChar(colName),
+		sqltypes.NewUint64(uint64(position)),
+		defaultVal,
+		sqltypes.NewVarChar(nullable),
+		sqltypes.NewVarChar(strings.ToLower(colDef.Type)),
+		sqltypes.MakeTrusted(sqltypes.Text, []byte(colDef.DescribeType())),
+		sqltypes.NewVarChar(key),
+		nullableVarChar(charset),
+		nullableVarChar(collation),
+	}
+}
+
+// nullableVarChar returns the given string as a VARCHAR value, or NULL if
+// it is empty.
+func nullableVarChar(val string) sqltypes.Value {
+	if val == "" {
+		return sqltypes.NULL
+	}
+	return sqltypes.NewVarChar(val)
+}
+
+// infoSchemaTablesFields contains the subset of the information_schema.tables
+// fields that are supported by the simulated tablet.
+var infoSchemaTablesFields = []*querypb.Field{
+	{Name: "TABLE_SCHEMA", Type: sqltypes.VarChar},
+	{Name: "TABLE_NAME", Type: sqltypes.VarChar},
+	{Name: "TABLE_TYPE", Type: sqltypes.VarChar},
+	{Name: "ENGINE", Type: sqltypes.VarChar},
+	{Name: "TABLE_ROWS", Type: sqltypes.Uint64},
+}
+
+// infoSchemaTablesRow returns the information_schema.tables row for the given
+// table.
+func (env *tabletEnv) infoSchemaTablesRow(table string) []sqltypes.Value {
+	return []sqltypes.Value{
+		sqltypes.NewVarChar(infoSchemaDBName),
+		sqltypes.NewVarChar(table),
+		sqltypes.NewVarChar("BASE TABLE"),
+		sqltypes.NewVarChar("InnoDB"),
+		sqltypes.NewUint64(uint64(env.tableNumRows(table))),
+	}
+}
+
+// infoSchemaKeyColumnUsageFields contains the subset of the
+// information_schema.key_column_usage fields that are supported by the
+// simulated tablet.
+var infoSchemaKeyColumnUsageFields = []*querypb.Field{
+	{Name: "CONSTRAINT_SCHEMA", Type: sqltypes.VarChar},
+	{Name: "CONSTRAINT_NAME", Type: sqltypes.VarChar},
+	{Name: "TABLE_SCHEMA", Type: sqltypes.VarChar},
+	{Name: "TABLE_NAME", Type: sqltypes.VarChar},
+	{Name: "COLUMN_NAME", Type: sqltypes.VarChar},
+	{Name: "ORDINAL_POSITION", Type: sqltypes.Uint64},
+	{Name: "REFERENCED_TABLE_SCHEMA", Type: sqltypes.VarChar},
+	{Name: "REFERENCED_TABLE_NAME", Type: sqltypes.VarChar},
+	{Name: "REFERENCED_COLUMN_NAME", Type: sqltypes.VarChar},
+}
+
+// infoSchemaKeyColumnUsageRow returns the information_schema.key_column_usage
+// row for a column of a unique key, or of a foreign key if fk is set, in
+// which case refIndex is the index of the column in the key.
+func infoSchemaKeyColumnUsageRow(constraint, table, column string, position int, fk *sqlparser.ForeignKeyDefinition, refIndex int) []sqltypes.Value {
+	row := []sqltypes.Value{
+		sqltypes.NewVarChar(infoSchemaDBName),
+		sqltypes.NewVarChar(constraint),
+		sqltypes.NewVarChar(infoSchemaDBName),
+		sqltypes.NewVarChar(table),
+		sqltypes.NewVarChar(column),
+		sqltypes.NewUint64(uint64(position)),
+	}
+	if fk == nil {
+		return append(row, sqltypes.NULL, sqltypes.NULL, sqltypes.NULL)
+	}
+	return append(row,
+		sqltypes.NewVarChar(infoSchemaDBName),
+		sqltypes.NewVarChar(fk.ReferencedTable.Name.String()),
+		sqltypes.NewVarChar(fk.ReferencedColumns[refIndex].String()),
+	)
+}
+
+// infoSchemaResult returns the result of a select on one of the simulated
+// information_schema tables. Rows are filtered by any equality comparisons
+// with literal values and null tests in the where clause, except for
+// table_schema since there is only a single database, and then projected
+// onto the selected columns.
+func (env *tabletEnv) infoSchemaResult(selStmt *sqlparser.Select, table *fromTable) (*sqltypes.Result, error) {
+	contents := env.infoSchemaTables[table.name]
+
+	fieldIndex := func(name string) int {
+		for i, field := range contents.Fields {
+			if strings.EqualFold(field.Name, name) {
+				return i
+			}
+		}
+		return -1
+	}
+
+	rows := contents.Rows
+	var filter func(expr sqlparser.Expr)
+	filter = func(expr sqlparser.Expr) {
+		switch expr := expr.(type) {
+		case *sqlparser.AndExpr:
+			filter(expr.Left)
+			filter(expr.Right)
+		case *sqlparser.ParenExpr:
+			filter(expr.Expr)
+		case *sqlparser.ComparisonExpr:
+			col, ok := expr.Left.(*sqlparser.ColName)
+			val, isVal := expr.Right.(*sqlparser.SQLVal)
+			if !ok || !isVal || expr.Operator != sqlparser.EqualStr || col.Name.EqualString("table_schema") {
+				return
+			}
+			idx := fieldIndex(col.Name.String())
+			if idx == -1 {
+				return
+			}
+			filtered := make([][]sqltypes.Value, 0, len(rows))
+			for _, row := range rows {
+				if row[idx].ToString() == string(val.Val) {
+					filtered = append(filtered, row)
+				}
+			}
+			rows = filtered
+		case *sqlparser.IsExpr:
+			col, ok := expr.Expr.(*sqlparser.ColName)
+			if !ok || (expr.Operator != sqlparser.IsNullStr && expr.Operator != sqlparser.IsNotNullStr) {
+				return
+			}
+			idx := fieldIndex(col.Name.String())
+			if idx == -1 {
+				return
+			}
+			filtered := make([][]sqltypes.Value, 0, len(rows))
+			for _, row := range rows {
+				if row[idx].IsNull() == (expr.Operator == sqlparser.IsNullStr) {
+					filtered = append(filtered, row)
+				}
+			}
+			rows = filtered
+		}
+	}
+	if selStmt.Where != nil {
+		filter(selStmt.Where.Expr)
+	}
+
+	var fields []*querypb.Field
+	var indexes []int
+	for _, node := range selStmt.SelectExprs {
+		switch node := node.(type) {
+		case *sqlparser.StarExpr:
+			for i, field := range contents.Fields {
+				fields = append(fields, field)
+				indexes = append(indexes, i)
+			}
+		case *sqlparser.AliasedExpr:
+			col, ok := node.Expr.(*sqlparser.ColName)
+			if !ok {
+				return nil, fmt.Errorf("unsupported select expression %s", sqlparser.String(node))
+			}
+			idx := fieldIndex(col.Name.String())
+			if idx == -1 {
+				return nil, fmt.Errorf("invalid column %s", sqlparser.String(col))
+			}
+			name := col.Name.String()
+			if !node.As.IsEmpty() {
+				name = node.As.String()
+			}
+			fields = append(fields, &querypb.Field{Name: name, Type: contents.Fields[idx].Type})
+			indexes = append(indexes, idx)
+		default:
+			return nil, fmt.Errorf("unsupported select expression %s", sqlparser.String(node))
+		}
+	}
+
+	result := &sqltypes.Result{
+		Fields:       fields,
+		RowsAffected: uint64(len(rows)),
+		Rows:         make([][]sqltypes.Value, 0, len(rows)),
+	}
+	for _, row := range rows {
+		projected := make([]sqltypes.Value, len(indexes))
+		for i, idx := range indexes {
+			projected[i] = row[idx]
+		}
+		result.Rows = append(result.Rows, projected)
+	}
+	return result, nil
+}
+
+// HandleQuery implements the fakesqldb query handler interface
+func (t *explainTablet) HandleQuery(c *mysql.Conn, query string, callback func(*sqltypes.Result) error) error {
+	if !t.env.multiStatements {
+		result, err := t.handleStatement(query)
+		if err != nil {
+			return err
+		}
+		if err := t.runQueryHook(query, result); err != nil {
+			return err
+		}
+		return callback(result)
+	}
+
+	// Run each statement in turn, stopping at the first error, and
+	// return the result of the last one.
+	var result *sqltypes.Result
+	for sql := query; sql != ""; {
+		stmt, rem, err := sqlparser.SplitStatement(sql)
+		if err != nil {
+			return queryError(err, query)
+		}
+		sql = strings.TrimSpace(rem)
+
+		stmt = strings.TrimSpace(stmt)
+		if stmt == "" {
+			continue
+		}
+		result, err = t.handleStatement(stmt)
+		if err != nil {
+			return err
+		}
+		if err := t.runQueryHook(stmt, result); err != nil {
+			return err
+		}
+	}
+	if result == nil {
+		return fmt.Errorf("empty query")
+	}
+	return callback(result)
+}
+
+// runQueryHook calls the query hook, if there is one, with a statement that
+// was simulated successfully and its result.
+func (t *explainTablet) runQueryHook(query string, result *sqltypes.Result) error {
+	if t.queryHook == nil {
+		return nil
+	}
+	// statements that the parser doesn't support are passed as nil
+	stmt, _ := sqlparser.Parse(query)
+	return t.queryHook(query, stmt, result)
+}
+
+// queryError adds the text of the query that failed to an error from
+// simulating it, so that it can be told apart from the many other queries
+// sent to mysql. Errors that mysql itself would return are left as is.
+func queryError(err error, query string) error {
+	if _, ok := err.(*mysql.SQLError); ok {
+		return err
+	}
+	return fmt.Errorf("%v in %s", err, query)
+}
+
+// stripComments returns the query without its leading and trailing comments,
+// which include optimizer hints like /*+ MAX_EXECUTION_TIME(1000) */.
+func stripComments(query string) string {
+	query, _ = sqlparser.SplitTrailingComments(sqlparser.StripLeadingComments(query))
+	return strings.TrimSpace(query)
+}
+
+// handleStatement returns the simulated result of a single statement.
+func (t *explainTablet) handleStatement(query string) (*sqltypes.Result, error) {
+	if !strings.Contains(query, "1 != 1") {
+		stmt, _ := sqlparser.Parse(query)
+		t.mysqlQueries = append(t.mysqlQueries, &MysqlQuery{
+			Time:         t.currentTime,
+			SQL:          query,
+			InsertValues: t.env.insertValues(stmt, nil),
+			BindVarTypes: t.env.bindVarTypes(stmt),
+		})
+	}
+
+	// fail any queries that were configured to return an error
+	for _, eq := range t.env.errorQueries {
+		if eq.expr.MatchString(query) {
+			return nil, eq.err
+		}
+	}
+
+	// comments and hints around the query don't change the result of the
+	// queries that are looked up by their text below
+	bareQuery := stripComments(query)
+
+	// system variables that were changed during the session override the
+	// pre-computed results below
+	if sysVar, ok := systemVarQueries[bareQuery]; ok {
+		if val, ok := t.systemVars[sysVar.name]; ok {
+			return &sqltypes.Result{
+				Fields:       []*querypb.Field{{Type: sysVar.typ}},
+				RowsAffected: 1,
+				Rows:         [][]sqltypes.Value{{sqltypes.MakeTrusted(sysVar.typ, []byte(val))}},
+			}, nil
+		}
+	}
+
+	// return the pre-computed results for any schema introspection queries
+	result, ok := t.env.schemaQueries[bareQuery]
+	if ok {
+		return result, nil
+	}
+
+	switch sqlparser.Preview(query) {
+	case sqlparser.StmtSelect:
+		// Parse the select statement to figure out the table and columns
+		// that were referenced so that the synthetic response has the
+		// expected field names and types.
+		stmt, err := sqlparser.Parse(query)
+		if err != nil {
+			return nil, queryError(err, query)
+		}
+
+		selStmt, ok := stmt.(sqlparser.SelectStatement)
+		if !ok {
+			return nil, fmt.Errorf("unsupported select statement %s", query)
+		}
+		result, err = t.env.selectResult(selStmt)
+		if err != nil {
+			return nil, queryError(t.env.addColumnSuggestions(err), query)
+		}
+
+		resultJSON, _ := json.MarshalIndent(result, "", "    ")
+		log.V(100).Infof("query %s result %s\n", query, string(resultJSON))
+
+		break
+	case sqlparser.StmtUse:
+		if err := t.useDatabase(query); err != nil {
+			return nil, err
+		}
+		result = &sqltypes.Result{}
+	case sqlparser.StmtBegin, sqlparser.StmtCommit:
+		result = &sqltypes.Result{}
+		break
+	case sqlparser.StmtSet:
+		if err := t.applySet(query); err != nil {
+			return nil, err
+		}
+		result = &sqltypes.Result{}
+	case sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete:
+		var err error
+		result, err = t.dmlResult(query)
+		if err != nil {
+			return nil, err
+		}
+	case sqlparser.StmtDDL:
+		// Schema changes are acknowledged without being applied, since
+		// the schema is shared by the tablets of all the shards, so
+		// subsequent queries still see the schema that vtexplain was
+		// started with.
+		if _, err := sqlparser.Parse(query); err != nil {
+			return nil, queryError(err, query)
+		}
+		result = &sqltypes.Result{}
+	default:
+		// vtexplain can't run the body of a stored procedure, so
+		// calls to them simply succeed without returning any rows.
+		if isProcedureCall(query) {
+			result = &sqltypes.Result{}
+			break
+		}
+		// Nor can it load data or maintain tables, so those statements
+		// also succeed without doing anything.
+		if isUtilityStatement(query) {
+			result = &sqltypes.Result{}
+			break
+		}
+		if result, ok, err := t.env.showColumnsResult(query); ok {
+			if err != nil {
+				return nil, queryError(err, query)
+			}
+			return result, nil
+		}
+		// Like mysql, truncate affects no rows and restarts the
+		// auto_increment values of the table.
+		if table := truncatedTable(query); table != "" {
+			if t.env.tableColumns[table] == nil {
+				return nil, queryError(fmt.Errorf("unable to resolve table name %s", table), query)
+			}
+			delete(t.autoIncrement, table)
+			result = &sqltypes.Result{}
+			break
+		}
+		return nil, fmt.Errorf("unsupported query %s", query)
+	}
+
+	return result, nil
+}
+
+// applySet records the values of the tracked system variables that are
+// changed by the given SET statement. All other variables, and statements
+// that can't be parsed, are accepted and ignored.
+func (t *explainTablet) applySet(query string) error {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil
+	}
+	set, ok := stmt.(*sqlparser.Set)
+	if !ok {
+		return fmt.Errorf("unsupported set statement %s", query)
+	}
+
+	for _, expr := range set.Exprs {
+		// The scope is parsed as the qualifier, e.g. @@session.sql_mode,
+		// while unqualified variables keep the @@ in their name.
+		scope := strings.TrimPrefix(strings.ToLower(expr.Name.Qualifier.Name.String()), "@@")
+		name := strings.TrimPrefix(expr.Name.Name.Lowered(), "@@")
+		switch scope {
+		case "", "session", "local":
+			scope = "session"
+		case "global":
+		default:
+			continue
+		}
+
+		var val string
+		switch name {
+		case "sql_mode", "time_zone":
+			val = setValue(expr.Expr)
+		case "autocommit":
+			switch strings.ToLower(setValue(expr.Expr)) {
+			case "1", "on", "true":
+				val = "1"
+			case "0", "off", "false":
+				val = "0"
+			default:
+				return mysql.NewSQLError(mysql.ERUnknownError, mysql.SSUnknownSQLState, "Variable 'autocommit' can't be set to the value of '%s'", setValue(expr.Expr))
+			}
+		default:
+			continue
+		}
+		t.systemVars[scope+"."+name] = val
+	}
+	return nil
+}
+
+// setValue returns the value assigned by a SET expression as a string.
+func setValue(expr sqlparser.Expr) string {
+	switch expr := expr.(type) {
+	case *sqlparser.SQLVal:
+		return string(expr.Val)
+	case *sqlparser.ColName:
+		return expr.Name.String()
+	case sqlparser.BoolVal:
+		if expr {
+			return "1"
+		}
+		return "0"
+	}
+	return sqlparser.String(expr)
+}
+
+// isProcedureCall returns true if the query is a CALL statement.
+func isProcedureCall(query string) bool {
+	fields := strings.Fields(sqlparser.StripLeadingComments(query))
+	return len(fields) != 0 && strings.EqualFold(fields[0], "call")
+}
+
+// useDatabase switches the tables that the tablet resolves queries against
+// to those of the keyspace named by a USE statement.
+func (t *explainTablet) useDatabase(query string) error {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return queryError(err, query)
+	}
+	use, ok := stmt.(*sqlparser.Use)
+	if !ok {
+		return fmt.Errorf("unsupported use statement %s", query)
+	}
+	name := use.DBName.String()
+	if name == "" {
+		return mysql.NewSQLError(mysql.ERNoDb, mysql.SSUnknownSQLState, "No database selected")
+	}
+	if name == t.keyspace {
+		t.env = t.keyspaceEnv
+		return nil
+	}
+	if t.databaseEnv != nil {
+		if env, ok := t.databaseEnv(name); ok {
+			t.env = env
+			return nil
+		}
+	}
+	return mysql.NewSQLError(mysql.ERBadDb, mysql.SSUnknownSQLState, "Unknown database '%s'", name)
+}
+
+// utilityStatements are the first words of the LOAD DATA, table maintenance
+// and FLUSH statements.
+var utilityStatements = map[string]bool{
+	"load":     true,
+	"analyze":  true,
+	"optimize": true,
+	"repair":   true,
+	"check":    true,
+	"checksum": true,
+	"flush":    true,
+}
+
+// isUtilityStatement returns true if the query is one of the
+// utilityStatements.
+func isUtilityStatement(query string) bool {
+	fields := strings.Fields(sqlparser.StripLeadingComments(query))
+	return len(fields) != 0 && utilityStatements[strings.ToLower(fields[0])]
+}
+
+// truncatedTable returns the table of a TRUNCATE statement, or "" for any
+// other query.
+func truncatedTable(query string) string {
+	if sqlparser.Preview(query) != sqlparser.StmtOther {
+		return ""
+	}
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return ""
+	}
+	ddl, ok := stmt.(*sqlparser.DDL)
+	if !ok || ddl.Action != sqlparser.TruncateStr {
+		return ""
+	}
+	return ddl.Table.Name.String()
+}
+
+// showColumnsRegexp matches 'show [full] columns from <table> [like
+// <pattern>]' and captures the full keyword, the table, the like clause
+// and its pattern.
+var showColumnsRegexp = regexp.MustCompile("(?i)^show\\s+(full\\s+)?(?:columns|fields)\\s+(?:from|in)\\s+`?(\\w+)`?(\\s+like\\s+'([^']*)')?$")
+
+// showColumnsResult returns the result of a 'show columns' statement,
+// which has the rows of 'describe <table>', or of 'show full columns', for
+// the columns that match its like pattern. It returns false for any other
+// query.
+func (env *tabletEnv) showColumnsResult(query string) (*sqltypes.Result, bool, error) {
+	match := showColumnsRegexp.FindStringSubmatch(strings.TrimSpace(query))
+	if match == nil {
+		return nil, false, nil
+	}
+	table := match[2]
+	if env.tableColumns[table] == nil {
+		return nil, true, fmt.Errorf("unable to resolve table name %s", table)
+	}
+	columns := env.schemaQueries["describe "+table]
+	if match[1] != "" {
+		columns = env.schemaQueries["show full columns from "+table]
+	}
+
+	var like *regexp.Regexp
+	if match[3] != "" {
+		like = likeRegexp(match[4])
+	}
+	result := &sqltypes.Result{Fields: columns.Fields}
+	for _, row := range columns.Rows {
+		if like == nil || like.MatchString(row[0].ToString()) {
+			result.Rows = append(result.Rows, row)
+		}
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result, true, nil
+}
+
+// likeRegexp returns the regexp that matches the same strings as the given
+// like pattern. It is case-insensitive, as the default collations of mysql.
+func likeRegexp(pattern string) *regexp.Regexp {
+	var b bytes.Buffer
+	b.WriteString("(?is)^")
+	escaped := false
+	for _, r := range pattern {
+		switch {
+		case escaped:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '%':
+			b.WriteString(".*")
+		case r == '_':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	if escaped {
+		// a trailing escape character matches itself
+		b.WriteString(`\\`)
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
+
+// selectResult returns a synthetic result for the given select statement.
+func (env *tabletEnv) selectResult(stmt sqlparser.SelectStatement) (*sqltypes.Result, error) {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		return env.simpleSelectResult(stmt)
+	case *sqlparser.ParenSelect:
+		return env.selectResult(stmt.Select)
+	case *sqlparser.Union:
+		return env.unionResult(stmt)
+	}
+	return nil, fmt.Errorf("unsupported select statement %s", sqlparser.String(stmt))
+}
+
+// simpleSelectResult returns a result for a single select, with the field
+// names and types of the columns that were referenced and the configured
+// number of rows of generated values.
+func (env *tabletEnv) simpleSelectResult(selStmt *sqlparser.Select) (*sqltypes.Result, error) {
+	tables, err := env.resolveFromTables(selStmt.From, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tables) == 1 && tables[0].infoSchema {
+		return env.infoSchemaResult(selStmt, tables[0])
+	}
+
+	// For complex select queries just return an empty result
+	// since it's too hard to figure out the real columns
+	if tables == nil {
+		return &sqltypes.Result{}, nil
+	}
+
+	// Predicates on the primary key of a single table limit the number
+	// of matching rows
+	if len(tables) == 1 && tables[0].table != "" && selStmt.Where != nil {
+		if n, ok := env.pkNumRows(tables[0].table, selStmt.Where.Expr); ok && n < tables[0].numRows {
+			tables[0].numRows = n
+		}
+	}
+
+	if result := countStarResult(selStmt, tables); result != nil {
+		return result, nil
+	}
+
+	colNames := make([]string, 0, 4)
+	colTypes := make([]querypb.Type, 0, 4)
+	colDefs := make([]*sqlparser.ColumnType, 0, 4)
+
+	// the schema table and column that the values of each column are
+	// generated for, with no table for expressions
+	colTables := make([]string, 0, 4)
+	srcColumns := make([]string, 0, 4)
+
+	// the values of the columns that are the same in every row, by index
+	constValues := make(map[int]sqltypes.Value)
+	for _, node := range selStmt.SelectExprs {
+		switch node := node.(type) {
+		case *sqlparser.AliasedExpr:
+			switch node := node.Expr.(type) {
+			case *sqlparser.ColName:
+				colType, table, err := resolveColumn(tables, node)
+				if err != nil {
+					return nil, err
+				}
+				colNames = append(colNames, node.Name.String())
+				colTypes = append(colTypes, colType)
+				colDefs = append(colDefs, table.colDefs[node.Name.String()])
+				colTables = append(colTables, table.table)
+				break
+			case *sqlparser.FuncExpr:
+				colType, err := resolveFuncType(tables, node)
+				if err != nil {
+					return nil, err
+				}
+				if node.Name.EqualString("version") {
+					constValues[len(colNames)] = sqltypes.NewVarChar(env.mysqlVersion)
+				}
+				colNames = append(colNames, sqlparser.String(node))
+				colTypes = append(colTypes, colType)
+				colDefs = append(colDefs, nil)
+				colTables = append(colTables, "")
+				break
+			case *sqlparser.SQLVal:
+				colType, err := resolveSQLValType(node)
+				if err != nil {
+					return nil, err
+				}
+				colNames = append(colNames, sqlparser.String(node))
+				colTypes = append(colTypes, colType)
+				colDefs = append(colDefs, nil)
+				colTables = append(colTables, "")
+				break
+			case *sqlparser.BinaryExpr, *sqlparser.UnaryExpr, *sqlparser.ParenExpr, *sqlparser.CaseExpr:
+				colType, err := resolveExprType(tables, node)
+				if err != nil {
+					return nil, err
 				}
+				colNames = append(colNames, sqlparser.String(node))
+				colTypes = append(colTypes, colType)
+				colDefs = append(colDefs, nil)
+				colTables = append(colTables, "")
 				break
-			case *sqlparser.StarExpr:
-				for col, colType := range colTypeMap {
+			default:
+				return nil, fmt.Errorf("unsupported select expression %s", sqlparser.String(node))
+			}
+			srcColumns = append(srcColumns, colNames[len(colNames)-1])
+			if !node.As.IsEmpty() {
+				colNames[len(colNames)-1] = node.As.String()
+			}
+			break
+		case *sqlparser.StarExpr:
+			for _, table := range tables {
+				if !node.TableName.IsEmpty() && node.TableName.Name.String() != table.name {
+					continue
+				}
+				// Expand in the declared order of the columns, like mysql
+				for _, col := range table.colNames {
 					colNames = append(colNames, col)
-					colTypes = append(colTypes, colType)
+					colTypes = append(colTypes, table.colTypes[col])
+					colDefs = append(colDefs, table.colDefs[col])
+					colTables = append(colTables, table.table)
+					srcColumns = append(srcColumns, col)
 				}
 			}
 		}
+	}
+
+	// The number of rows is driven by the first table in the join, capped
+	// by a literal limit if there is one. mysql removes the duplicate rows
+	// of a distinct select before applying its limit, so the limit of
+	// those is applied to the generated rows instead.
+	numRows := tables[0].numRows
+	if selStmt.Limit != nil && selStmt.Distinct == "" {
+		offset, limit := limitValue(selStmt.Limit.Offset), limitValue(selStmt.Limit.Rowcount)
+		if offset > 0 {
+			numRows -= offset
+			if numRows < 0 {
+				numRows = 0
+			}
+		}
+		if limit >= 0 && limit < numRows {
+			numRows = limit
+		}
+	}
+
+	fields := make([]*querypb.Field, len(colNames))
+	for i, col := range colNames {
+		fields[i] = &querypb.Field{
+			Name: col,
+			Type: colTypes[i],
+		}
+	}
 
-		fields := make([]*querypb.Field, len(colNames))
+	rows := make([][]sqltypes.Value, 0, numRows)
+	for r := 0; r < numRows; r++ {
 		values := make([]sqltypes.Value, len(colNames))
 		for i, col := range colNames {
-			colType := colTypes[i]
-			fields[i] = &querypb.Field{
-				Name: col,
-				Type: colType,
+			if value, ok := constValues[i]; ok {
+				values[i] = value
+			} else if seeds := env.columnValues[colDefs[i]]; len(seeds) != 0 {
+				values[i], err = sqltypes.NewValue(colTypes[i], []byte(seeds[r%len(seeds)]))
+			} else if env.valueGenerator != nil {
+				values[i], err = env.valueGenerator.Generate(colTables[i], srcColumns[i], colTypes[i], r)
+			} else {
+				values[i], err = generateValue(col, colTypes[i], colDefs[i], r*len(colNames)+i+1, env.baseTime)
+			}
+			if err != nil {
+				return nil, err
 			}
+		}
+		rows = append(rows, values)
+	}
+	if selStmt.Distinct != "" {
+		rows = distinctRows(rows)
+		if selStmt.Limit != nil {
+			rows = limitRows(rows, limitValue(selStmt.Limit.Offset), limitValue(selStmt.Limit.Rowcount))
+		}
+	}
+	return &sqltypes.Result{
+		Fields:       fields,
+		RowsAffected: uint64(len(rows)),
+		InsertID:     0,
+		Rows:         rows,
+	}, nil
+}
 
-			// Generate a fake value for the given column. For numeric types,
-			// use the column index. For all other types, just shortcut to using
-			// a string type that encodes the column name + index.
-			if sqltypes.IsIntegral(colType) {
-				values[i] = sqltypes.NewInt32(int32(i + 1))
-			} else if sqltypes.IsFloat(colType) {
-				values[i] = sqltypes.NewFloat64(1.0 + float64(i))
-			} else {
-				values[i] = sqltypes.NewVarChar(fmt.Sprintf("%s_val_%d", col, i+1))
+// distinctRows returns the given rows without the duplicates, keeping the
+// first occurrence of each row.
+func distinctRows(rows [][]sqltypes.Value) [][]sqltypes.Value {
+	seen := make(map[string]bool)
+	distinct := make([][]sqltypes.Value, 0, len(rows))
+	for _, row := range rows {
+		key := fmt.Sprintf("%v", row)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		distinct = append(distinct, row)
+	}
+	return distinct
+}
+
+// limitRows returns the rows selected by the given offset and row count
+// of a limit clause, either of which is ignored if negative.
+func limitRows(rows [][]sqltypes.Value, offset, limit int) [][]sqltypes.Value {
+	if offset > len(rows) {
+		offset = len(rows)
+	}
+	if offset > 0 {
+		rows = rows[offset:]
+	}
+	if limit >= 0 && limit < len(rows) {
+		rows = rows[:limit]
+	}
+	return rows
+}
+
+// unionResult returns a result with the fields of the left-most select in the
+// union. UNION ALL returns the rows from both sides, and as a coarse
+// approximation a distinct UNION treats the rows on the right as duplicates.
+func (env *tabletEnv) unionResult(union *sqlparser.Union) (*sqltypes.Result, error) {
+	left, err := env.selectResult(union.Left)
+	if err != nil {
+		return nil, err
+	}
+	right, err := env.selectResult(union.Right)
+	if err != nil {
+		return nil, err
+	}
+
+	// If either side is too complex to figure out, neither is the union
+	if left.Fields == nil || right.Fields == nil {
+		return &sqltypes.Result{}, nil
+	}
+	if len(left.Fields) != len(right.Fields) {
+		return nil, fmt.Errorf("the used select statements have a different number of columns: %s", sqlparser.String(union))
+	}
+
+	rows := make([][]sqltypes.Value, 0, len(left.Rows)+len(right.Rows))
+	rows = append(rows, left.Rows...)
+	if union.Type == sqlparser.UnionAllStr {
+		rows = append(rows, right.Rows...)
+	}
+	return &sqltypes.Result{
+		Fields:       left.Fields,
+		RowsAffected: uint64(len(rows)),
+		Rows:         rows,
+	}, nil
+}
+
+// generateValue returns a fake value for the given column. If the column
+// definition is known, its declared default is used, nullable columns without
+// a default are NULL, and enum columns use one of their allowed values.
+// Otherwise for numeric types, it uses the given index, and temporal types get
+// the given base time. For all other types, just shortcut to using a string
+// type that encodes the column name + index.
+func generateValue(col string, colType querypb.Type, colDef *sqlparser.ColumnType, n int, baseTime time.Time) (sqltypes.Value, error) {
+	if colDef != nil {
+		if def := colDef.Default; def != nil {
+			switch def.Type {
+			case sqlparser.ValArg:
+				// DEFAULT NULL or DEFAULT CURRENT_TIMESTAMP. The latter
+				// falls through to generating a value as usual.
+				if strings.EqualFold(string(def.Val), "null") {
+					return sqltypes.NULL, nil
+				}
+			case sqlparser.StrVal:
+				if sqltypes.IsQuoted(colType) {
+					return sqltypes.MakeTrusted(colType, def.Val), nil
+				}
+				return sqltypes.NewValue(colType, def.Val)
+			default:
+				return sqltypes.NewValue(colType, def.Val)
 			}
+		} else if !colDef.NotNull {
+			return sqltypes.NULL, nil
 		}
-		result = &sqltypes.Result{
-			Fields:       fields,
-			RowsAffected: 1,
-			InsertID:     0,
-			Rows:         [][]sqltypes.Value{values},
+
+		// For enum and set columns use the first of the allowed values,
+		// which are stored with their surrounding quotes.
+		if len(colDef.EnumValues) != 0 {
+			val := colDef.EnumValues[0]
+			return sqltypes.MakeTrusted(colType, []byte(val[1:len(val)-1])), nil
 		}
+	}
 
-		resultJSON, _ := json.MarshalIndent(result, "", "    ")
-		log.V(100).Infof("query %s result %s\n", query, string(resultJSON))
+	switch {
+	case colType == sqltypes.Null:
+		return sqltypes.NULL, nil
+	case colType == sqltypes.Decimal:
+		// Honor the scale of the column, e.g. 1.00 for decimal(10,2)
+		scale := 0
+		if colDef != nil && colDef.Scale != nil {
+			scale, _ = strconv.Atoi(string(colDef.Scale.Val))
+		}
+		return sqltypes.NewValue(colType, strconv.AppendFloat(nil, float64(n), 'f', scale, 64))
+	case sqltypes.IsIntegral(colType):
+		return sqltypes.NewValue(colType, strconv.AppendInt(nil, int64(n), 10))
+	case sqltypes.IsFloat(colType):
+		return sqltypes.NewValue(colType, strconv.AppendFloat(nil, float64(n), 'g', -1, 64))
+	}
 
-		break
-	case sqlparser.StmtBegin, sqlparser.StmtCommit:
-		result = &sqltypes.Result{}
-		break
-	case sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete:
-		result = &sqltypes.Result{
-			RowsAffected: 1,
+	switch colType {
+	case sqltypes.Date:
+		return sqltypes.NewValue(colType, []byte(baseTime.Format("2006-01-02")))
+	case sqltypes.Datetime, sqltypes.Timestamp:
+		return sqltypes.NewValue(colType, []byte(baseTime.Format("2006-01-02 15:04:05")))
+	case sqltypes.Time:
+		return sqltypes.NewValue(colType, []byte(baseTime.Format("15:04:05")))
+	case sqltypes.Year:
+		return sqltypes.NewValue(colType, []byte(baseTime.Format("2006")))
+	case sqltypes.Bit:
+		return sqltypes.MakeTrusted(colType, []byte{byte(n)}), nil
+	case sqltypes.TypeJSON:
+		// The values of json columns must be valid documents
+		return sqltypes.MakeTrusted(colType, []byte("{}")), nil
+	}
+	if sqltypes.IsQuoted(colType) {
+		return sqltypes.MakeTrusted(colType, []byte(fmt.Sprintf("%s_val_%d", col, n))), nil
+	}
+	return sqltypes.NewVarChar(fmt.Sprintf("%s_val_%d", col, n)), nil
+}
+
+// countStarResult returns the result of a select whose only projection is
+// count(*) without a group by, which is a single row with the number of
+// rows in the first table. It returns nil for all other selects.
+func countStarResult(selStmt *sqlparser.Select, tables []*fromTable) *sqltypes.Result {
+	if len(selStmt.SelectExprs) != 1 || len(selStmt.GroupBy) != 0 {
+		return nil
+	}
+	node, ok := selStmt.SelectExprs[0].(*sqlparser.AliasedExpr)
+	if !ok {
+		return nil
+	}
+	funcExpr, ok := node.Expr.(*sqlparser.FuncExpr)
+	if !ok || funcExpr.Name.Lowered() != "count" || funcExpr.Distinct || len(funcExpr.Exprs) != 1 {
+		return nil
+	}
+	if _, ok := funcExpr.Exprs[0].(*sqlparser.StarExpr); !ok {
+		return nil
+	}
+
+	name := sqlparser.String(funcExpr)
+	if !node.As.IsEmpty() {
+		name = node.As.String()
+	}
+	return &sqltypes.Result{
+		Fields:       []*querypb.Field{{Name: name, Type: sqltypes.Int64}},
+		RowsAffected: 1,
+		Rows:         [][]sqltypes.Value{{sqltypes.NewInt64(int64(tables[0].numRows))}},
+	}
+}
+
+// limitValue returns the value of a literal offset or row count in a limit
+// clause, or -1 if it is missing or can't be resolved, e.g. a bind variable.
+func limitValue(expr sqlparser.Expr) int {
+	val, ok := expr.(*sqlparser.SQLVal)
+	if !ok || val.Type != sqlparser.IntVal {
+		return -1
+	}
+	n, err := strconv.Atoi(string(val.Val))
+	if err != nil {
+		return -1
+	}
+	return n
+}
+
+// tableNumRows returns the number of rows that simulated queries against the
+// given table should return or affect.
+func (env *tabletEnv) tableNumRows(table string) int {
+	if n, ok := env.tableRowCounts[table]; ok {
+		return n
+	}
+	return env.defaultRowCount
+}
+
+// dmlResult returns the result of a simulated insert, update or delete.
+// Inserts affect one row per row of values and generate an InsertID if the
+// table has an auto_increment column, while updates and deletes affect the
+// number of rows estimated from their where clause. As in mysql, statements
+// that explicitly set the value of a generated column fail, and so do
+// inserts that don't set a required column if strictNotNull is set.
+func (t *explainTablet) dmlResult(query string) (*sqltypes.Result, error) {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return &sqltypes.Result{RowsAffected: uint64(t.env.defaultRowCount)}, nil
+	}
+
+	table := dmlTableName(stmt)
+	var setColumns []sqlparser.ColIdent
+	switch stmt := stmt.(type) {
+	case *sqlparser.Insert:
+		setColumns = stmt.Columns
+	case *sqlparser.Update:
+		for _, expr := range stmt.Exprs {
+			setColumns = append(setColumns, expr.Name.Name)
+		}
+	}
+	for _, col := range setColumns {
+		if colDef := t.env.tableColumnDefs[table][col.String()]; colDef != nil && colDef.GeneratedExpr != nil {
+			return nil, mysql.NewSQLError(mysql.ERUnknownError, mysql.SSUnknownSQLState, "The value specified for generated column '%s' in table '%s' is not allowed.", col.String(), table)
+		}
+	}
+	if insert, ok := stmt.(*sqlparser.Insert); ok && t.env.strictNotNull {
+		if col := t.env.missingRequiredColumn(table, insert.Columns); col != "" {
+			return nil, mysql.NewSQLError(mysql.ERNoDefaultForField, mysql.SSUnknownSQLState, "Field '%s' doesn't have a default value", col)
 		}
-		break
-	default:
-		return fmt.Errorf("unsupported query %s", query)
 	}
 
-	return callback(result)
+	result := &sqltypes.Result{
+		RowsAffected: uint64(t.env.tableNumRows(table)),
+	}
+
+	switch stmt := stmt.(type) {
+	case *sqlparser.Insert:
+		if values, ok := stmt.Rows.(sqlparser.Values); ok {
+			result.RowsAffected = uint64(len(values))
+		}
+		if t.env.tableAutoIncrement[table] != "" {
+			result.InsertID = t.autoIncrement[table] + 1
+			t.autoIncrement[table] += result.RowsAffected
+		}
+		if stmt.Action == sqlparser.ReplaceStr && t.env.replaceConflict {
+			// each row is deleted and inserted again
+			result.RowsAffected *= 2
+		}
+		if len(stmt.OnDup) != 0 && t.env.duplicateKeyConflict {
+			// each row updates the existing row instead
+			result.RowsAffected *= 2
+		}
+	case *sqlparser.Update:
+		if stmt.Where != nil {
+			result.RowsAffected = uint64(t.env.whereNumRows(table, stmt.Where.Expr))
+		}
+	case *sqlparser.Delete:
+		if stmt.Where != nil {
+			result.RowsAffected = uint64(t.env.whereNumRows(table, stmt.Where.Expr))
+		}
+	}
+	return result, nil
+}
+
+// missingRequiredColumn returns the first column of the table that an
+// insert with the given column list doesn't set even though it is not null
+// and has no default, or an empty string if there is none. Inserts without
+// a column list set all the columns.
+func (env *tabletEnv) missingRequiredColumn(table string, columns sqlparser.Columns) string {
+	if len(columns) == 0 {
+		return ""
+	}
+	for _, colName := range env.tableColumnNames[table] {
+		colDef := env.tableColumnDefs[table][colName]
+		if !colDef.NotNull || colDef.Default != nil || colDef.Autoincrement || colDef.GeneratedExpr != nil {
+			continue
+		}
+		if columns.FindColumn(sqlparser.NewColIdent(colName)) < 0 {
+			return colName
+		}
+	}
+	return ""
+}
+
+// whereNumRows estimates the number of rows of the table that match the
+// given where clause of an update or delete, using pkNumRows. Anything more
+// complex is assumed to match a single row.
+func (env *tabletEnv) whereNumRows(table string, expr sqlparser.Expr) int {
+	if n, ok := env.pkNumRows(table, expr); ok {
+		return n
+	}
+	return 1
+}
+
+// pkNumRows estimates the number of rows of the table that match the given
+// predicate on its primary key. An equality matches a single row, an in
+// list (as generated by the tabletserver for dmls) one row per value, and a
+// between on integers one row per value in the range, bounded by the number
+// of rows of the table. Comparisons with null and is null tests match no
+// rows. The smallest estimate of the terms of an and is used. It returns
+// false if the predicate doesn't constrain the primary key in one of these
+// ways.
+func (env *tabletEnv) pkNumRows(table string, expr sqlparser.Expr) (int, bool) {
+	switch expr := expr.(type) {
+	case *sqlparser.AndExpr:
+		left, leftOK := env.pkNumRows(table, expr.Left)
+		right, rightOK := env.pkNumRows(table, expr.Right)
+		switch {
+		case leftOK && rightOK:
+			if right < left {
+				return right, true
+			}
+			return left, true
+		case leftOK:
+			return left, true
+		}
+		return right, rightOK
+	case *sqlparser.ParenExpr:
+		return env.pkNumRows(table, expr.Expr)
+	case *sqlparser.ComparisonExpr:
+		if !env.isPrimaryKey(table, expr.Left) {
+			return 0, false
+		}
+		switch expr.Operator {
+		case sqlparser.EqualStr, sqlparser.NullSafeEqualStr:
+			switch expr.Right.(type) {
+			case *sqlparser.SQLVal, sqlparser.ValTuple:
+				return 1, true
+			case *sqlparser.NullVal:
+				// Nothing is equal to null, and the primary key
+				// is never null
+				return 0, true
+			}
+		case sqlparser.InStr:
+			if values, ok := expr.Right.(sqlparser.ValTuple); ok && len(values) > 0 {
+				return env.inListNumRows(table, values), true
+			}
+		}
+	case *sqlparser.IsExpr:
+		// The primary key is never null, while is not null and the
+		// boolean tests don't constrain it
+		if expr.Operator == sqlparser.IsNullStr && env.isPrimaryKey(table, expr.Expr) {
+			return 0, true
+		}
+	case *sqlparser.RangeCond:
+		if expr.Operator != sqlparser.BetweenStr || !env.isPrimaryKey(table, expr.Left) {
+			return 0, false
+		}
+		from, to := intValue(expr.From), intValue(expr.To)
+		if from == nil || to == nil {
+			return 0, false
+		}
+		n := *to - *from + 1
+		if n < 0 {
+			n = 0
+		}
+		if max := int64(env.tableNumRows(table)); n > max {
+			n = max
+		}
+		return int(n), true
+	}
+	return 0, false
+}
+
+// inListNumRows returns the number of rows matched by a list of values of
+// the primary key of the table, which is the number of distinct values as
+// compared with the collations of the key columns. Values that aren't
+// literals are assumed to be distinct.
+func (env *tabletEnv) inListNumRows(table string, values sqlparser.ValTuple) int {
+	pkCols := env.tablePKColumns[table]
+	seen := make(map[string]bool)
+	n := 0
+	for _, value := range values {
+		tuple, ok := value.(sqlparser.ValTuple)
+		if !ok {
+			tuple = sqlparser.ValTuple{value}
+		}
+		if key, ok := env.pkValueKey(table, pkCols, tuple); ok {
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+		}
+		n++
+	}
+	return n
+}
+
+// pkValueKey returns a key that is the same for the values of the given
+// columns that mysql considers equal, or false if any of the values isn't
+// a literal.
+func (env *tabletEnv) pkValueKey(table string, cols []string, values sqlparser.ValTuple) (string, bool) {
+	if len(values) != len(cols) {
+		return "", false
+	}
+	keys := make([]string, len(values))
+	for i, value := range values {
+		val, ok := value.(*sqlparser.SQLVal)
+		if !ok || val.Type == sqlparser.ValArg {
+			return "", false
+		}
+		keys[i] = string(val.Val)
+		if val.Type == sqlparser.StrVal && strings.HasSuffix(env.columnCollations[table][cols[i]], "_ci") {
+			keys[i] = strings.ToLower(keys[i])
+		}
+	}
+	return strings.Join(keys, "\x00"), true
+}
+
+// isPrimaryKey returns true if the given column, or tuple of columns, is the
+// whole primary key of the table.
+func (env *tabletEnv) isPrimaryKey(table string, expr sqlparser.Expr) bool {
+	var cols []string
+	switch expr := expr.(type) {
+	case *sqlparser.ColName:
+		cols = []string{expr.Name.String()}
+	case sqlparser.ValTuple:
+		for _, e := range expr {
+			col, ok := e.(*sqlparser.ColName)
+			if !ok {
+				return false
+			}
+			cols = append(cols, col.Name.String())
+		}
+	}
+	pkCols := env.tablePKColumns[table]
+	if len(cols) == 0 || len(cols) != len(pkCols) {
+		return false
+	}
+	for i, col := range cols {
+		if !strings.EqualFold(col, pkCols[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// intValue returns the value of an integer literal, or nil for anything
+// else.
+func intValue(expr sqlparser.Expr) *int64 {
+	val, ok := expr.(*sqlparser.SQLVal)
+	if !ok || val.Type != sqlparser.IntVal {
+		return nil
+	}
+	n, err := strconv.ParseInt(string(val.Val), 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &n
+}
+
+// dmlTableName returns the name of the table targeted by the given DML
+// statement, or "" if it can't be determined.
+func dmlTableName(stmt sqlparser.Statement) string {
+	var exprs sqlparser.TableExprs
+	switch stmt := stmt.(type) {
+	case *sqlparser.Insert:
+		return stmt.Table.Name.String()
+	case *sqlparser.Update:
+		exprs = stmt.TableExprs
+	case *sqlparser.Delete:
+		exprs = stmt.TableExprs
+	}
+	if len(exprs) == 0 {
+		return ""
+	}
+	if node, ok := exprs[0].(*sqlparser.AliasedTableExpr); ok {
+		return sqlparser.GetTableName(node.Expr).String()
+	}
+	return ""
+}
+
+// fromTable is a table referenced in the FROM clause of a select, along with
+// the name (or alias) that the rest of the query uses to refer to it.
+type fromTable struct {
+	name     string
+	colNames []string
+	colTypes map[string]querypb.Type
+	colDefs  map[string]*sqlparser.ColumnType
+	numRows  int
+
+	// table is the name of the schema table, empty for derived tables
+	table string
+
+	// infoSchema is set for the simulated information_schema tables, whose
+	// results are computed from the schema rather than generated
+	infoSchema bool
+}
+
+// resolveFromTables walks the table expressions of a FROM clause, descending
+// into any joins or parenthesized expressions, and appends each referenced
+// table to the given list. It returns nil if any of the expressions is too
+// complex to be resolved to a known table.
+func (env *tabletEnv) resolveFromTables(exprs sqlparser.TableExprs, tables []*fromTable) ([]*fromTable, error) {
+	for _, expr := range exprs {
+		var err error
+		switch node := expr.(type) {
+		case *sqlparser.AliasedTableExpr:
+			if subquery, ok := node.Expr.(*sqlparser.Subquery); ok {
+				derived, err := env.resolveDerivedTable(subquery, node.As)
+				if err != nil || derived == nil {
+					return nil, err
+				}
+				tables = append(tables, derived)
+				continue
+			}
+
+			table := sqlparser.GetTableName(node.Expr)
+			if table.IsEmpty() {
+				return nil, nil
+			}
+
+			if tableName, ok := node.Expr.(sqlparser.TableName); ok && strings.EqualFold(tableName.Qualifier.String(), "information_schema") {
+				name := strings.ToLower(table.String())
+				if env.infoSchemaTables[name] == nil {
+					return nil, fmt.Errorf("unsupported information_schema table %s", table.String())
+				}
+				tables = append(tables, &fromTable{name: name, infoSchema: true})
+				continue
+			}
+
+			colTypes := env.tableColumns[table.String()]
+			if colTypes == nil {
+				return nil, fmt.Errorf("unable to resolve table name %s", table.String())
+			}
+			name := table.String()
+			if !node.As.IsEmpty() {
+				name = node.As.String()
+			}
+			tables = append(tables, &fromTable{
+				name:     name,
+				table:    table.String(),
+				colNames: env.tableColumnNames[table.String()],
+				colTypes: colTypes,
+				colDefs:  env.tableColumnDefs[table.String()],
+				numRows:  env.tableNumRows(table.String()),
+			})
+		case *sqlparser.JoinTableExpr:
+			tables, err = env.resolveFromTables(sqlparser.TableExprs{node.LeftExpr, node.RightExpr}, tables)
+		case *sqlparser.ParenTableExpr:
+			tables, err = env.resolveFromTables(node.Exprs, tables)
+		default:
+			return nil, fmt.Errorf("unsupported table expression %s", sqlparser.String(node))
+		}
+		if err != nil || tables == nil {
+			return nil, err
+		}
+	}
+	return tables, nil
+}
+
+// resolveDerivedTable resolves the output columns of a subquery in the FROM
+// clause so that the outer select can refer to them by the derived table's
+// alias. It returns nil if the subquery is too complex to be resolved.
+func (env *tabletEnv) resolveDerivedTable(subquery *sqlparser.Subquery, alias sqlparser.TableIdent) (*fromTable, error) {
+	result, err := env.selectResult(subquery.Select)
+	if err != nil || result.Fields == nil {
+		return nil, err
+	}
+
+	colNames := make([]string, 0, len(result.Fields))
+	colTypes := make(map[string]querypb.Type)
+	for _, field := range result.Fields {
+		colNames = append(colNames, field.Name)
+		colTypes[field.Name] = field.Type
+	}
+	return &fromTable{
+		name:     alias.String(),
+		colNames: colNames,
+		colTypes: colTypes,
+		numRows:  len(result.Rows),
+	}, nil
+}
+
+// resolveColumn returns the type of the given column and the table it belongs
+// to. Qualified columns are looked up in the table with the matching name or
+// alias, and unqualified columns must exist in exactly one of the referenced
+// tables.
+func resolveColumn(tables []*fromTable, col *sqlparser.ColName) (querypb.Type, *fromTable, error) {
+	colName := col.Name.String()
+	if !col.Qualifier.IsEmpty() {
+		qualifier := col.Qualifier.Name.String()
+		for _, t := range tables {
+			if t.name != qualifier {
+				continue
+			}
+			colType, ok := t.colTypes[colName]
+			if !ok {
+				return querypb.Type_NULL_TYPE, nil, newInvalidColumnError(tables, col)
+			}
+			return colType, t, nil
+		}
+		return querypb.Type_NULL_TYPE, nil, fmt.Errorf("unable to resolve table name %s", qualifier)
+	}
+
+	var found *fromTable
+	for _, t := range tables {
+		if _, ok := t.colTypes[colName]; !ok {
+			continue
+		}
+		if found != nil {
+			return querypb.Type_NULL_TYPE, nil, fmt.Errorf("column %s is ambiguous in %s and %s", colName, found.name, t.name)
+		}
+		found = t
+	}
+	if found == nil {
+		return querypb.Type_NULL_TYPE, nil, newInvalidColumnError(tables, col)
+	}
+	return found.colTypes[colName], found, nil
+}
+
+// invalidColumnError is returned for columns that don't exist in the
+// tables they are looked up in. It suggests the columns with the same name
+// in other tables.
+type invalidColumnError struct {
+	column      string
+	name        string
+	suggestions []string
+}
+
+// newInvalidColumnError returns the error for the given column, suggesting
+// the columns with the same name in the other tables of the query.
+func newInvalidColumnError(tables []*fromTable, col *sqlparser.ColName) *invalidColumnError {
+	err := &invalidColumnError{
+		column: sqlparser.String(col),
+		name:   col.Name.String(),
+	}
+	for _, t := range tables {
+		if _, ok := t.colTypes[err.name]; ok && t.name != col.Qualifier.Name.String() {
+			err.suggestions = append(err.suggestions, t.name+"."+err.name)
+		}
+	}
+	return err
+}
+
+func (e *invalidColumnError) Error() string {
+	if len(e.suggestions) == 0 {
+		return fmt.Sprintf("invalid column %s", e.column)
+	}
+	return fmt.Sprintf("invalid column %s (did you mean %s?)", e.column, strings.Join(e.suggestions, " or "))
+}
+
+// addColumnSuggestions adds the columns with the same name in the other
+// tables of the schema to an invalidColumnError that has no suggestion from
+// the tables of the query. Other errors are returned as is.
+func (env *tabletEnv) addColumnSuggestions(err error) error {
+	colErr, ok := err.(*invalidColumnError)
+	if !ok || len(colErr.suggestions) != 0 {
+		return err
+	}
+	tables := make([]string, 0, len(env.tableColumns))
+	for table := range env.tableColumns {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	for _, table := range tables {
+		if _, ok := env.tableColumns[table][colErr.name]; ok {
+			colErr.suggestions = append(colErr.suggestions, table+"."+colErr.name)
+		}
+	}
+	return colErr
+}
+
+// resolveFuncType returns the type of the result of the given function call.
+// The common aggregate and scalar functions are special-cased to match what
+// mysql would return, MAX and MIN inherit the type of their argument, and as
+// a shortcut all other functions are treated as integral types.
+func resolveFuncType(tables []*fromTable, node *sqlparser.FuncExpr) (querypb.Type, error) {
+	switch node.Name.Lowered() {
+	case "count":
+		return querypb.Type_INT64, nil
+	case "sum", "avg":
+		return querypb.Type_DECIMAL, nil
+	case "max", "min", "any_value":
+		if len(node.Exprs) == 1 {
+			if expr, ok := node.Exprs[0].(*sqlparser.AliasedExpr); ok {
+				if col, ok := expr.Expr.(*sqlparser.ColName); ok {
+					colType, _, err := resolveColumn(tables, col)
+					return colType, err
+				}
+			}
+		}
+	case "concat", "concat_ws", "lower", "lcase", "upper", "ucase", "substr", "substring",
+		"trim", "ltrim", "rtrim", "left", "right", "replace", "lpad", "rpad", "hex", "version":
+		return querypb.Type_VARCHAR, nil
+	case "length", "char_length", "character_length":
+		return querypb.Type_INT64, nil
+	}
+	return querypb.Type_INT32, nil
+}
+
+// resolveSQLValType returns the type of a literal value.
+func resolveSQLValType(node *sqlparser.SQLVal) (querypb.Type, error) {
+	switch node.Type {
+	case sqlparser.IntVal, sqlparser.HexNum:
+		return querypb.Type_INT32, nil
+	case sqlparser.HexVal:
+		return querypb.Type_VARBINARY, nil
+	case sqlparser.BitVal:
+		return querypb.Type_BIT, nil
+	case sqlparser.StrVal:
+		return querypb.Type_VARCHAR, nil
+	case sqlparser.FloatVal:
+		return querypb.Type_FLOAT64, nil
+	}
+	return querypb.Type_NULL_TYPE, fmt.Errorf("unsupported sql value %s", sqlparser.String(node))
+}
+
+// resolveExprType returns the type of an expression made of columns,
+// literals, functions and arithmetic on them.
+func resolveExprType(tables []*fromTable, expr sqlparser.Expr) (querypb.Type, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.ColName:
+		colType, _, err := resolveColumn(tables, expr)
+		return colType, err
+	case *sqlparser.FuncExpr:
+		return resolveFuncType(tables, expr)
+	case *sqlparser.SQLVal:
+		return resolveSQLValType(expr)
+	case *sqlparser.NullVal:
+		return querypb.Type_NULL_TYPE, nil
+	case *sqlparser.ParenExpr:
+		return resolveExprType(tables, expr.Expr)
+	case *sqlparser.CaseExpr:
+		results := make([]sqlparser.Expr, 0, len(expr.Whens)+1)
+		for _, when := range expr.Whens {
+			results = append(results, when.Val)
+		}
+		if expr.Else != nil {
+			results = append(results, expr.Else)
+		}
+		typ := querypb.Type_NULL_TYPE
+		for _, result := range results {
+			resultType, err := resolveExprType(tables, result)
+			if err != nil {
+				return querypb.Type_NULL_TYPE, err
+			}
+			typ = unifyTypes(typ, resultType)
+		}
+		return typ, nil
+	case *sqlparser.UnaryExpr:
+		operandType, err := resolveExprType(tables, expr.Expr)
+		if err != nil {
+			return querypb.Type_NULL_TYPE, err
+		}
+		switch expr.Operator {
+		case sqlparser.TildaStr:
+			return querypb.Type_UINT64, nil
+		case sqlparser.BangStr:
+			return querypb.Type_INT64, nil
+		}
+		// unary plus or minus, where negating an unsigned integer gives
+		// a signed one
+		typ := arithmeticType(sqlparser.PlusStr, operandType, querypb.Type_INT64)
+		if typ == querypb.Type_UINT64 && expr.Operator == sqlparser.UMinusStr {
+			return querypb.Type_INT64, nil
+		}
+		return typ, nil
+	case *sqlparser.BinaryExpr:
+		leftType, err := resolveExprType(tables, expr.Left)
+		if err != nil {
+			return querypb.Type_NULL_TYPE, err
+		}
+		rightType, err := resolveExprType(tables, expr.Right)
+		if err != nil {
+			return querypb.Type_NULL_TYPE, err
+		}
+		return arithmeticType(expr.Operator, leftType, rightType), nil
+	}
+	return querypb.Type_NULL_TYPE, fmt.Errorf("unsupported select expression %s", sqlparser.String(expr))
+}
+
+// unifyTypes returns the type of an expression whose value can have either
+// of the given types, such as the result of a case expression. NULL goes
+// with any type, numbers combine as in arithmetic, and all other mixed
+// types fall back to VARCHAR.
+func unifyTypes(a, b querypb.Type) querypb.Type {
+	isNumber := func(typ querypb.Type) bool {
+		return sqltypes.IsIntegral(typ) || sqltypes.IsFloat(typ) || typ == sqltypes.Decimal
+	}
+	switch {
+	case a == b || b == sqltypes.Null:
+		return a
+	case a == sqltypes.Null:
+		return b
+	case isNumber(a) && isNumber(b):
+		return arithmeticType(sqlparser.PlusStr, a, b)
+	}
+	return querypb.Type_VARCHAR
+}
+
+// arithmeticType returns the type of the result of an arithmetic operator
+// with operands of the given types, following the mysql rules: integer
+// operands give an integer, which is unsigned if either operand is, any
+// floating point or string operand gives a double, and anything else gives
+// a decimal. Division always gives a decimal or a double, while integer
+// division and bit operations always give an integer.
+func arithmeticType(operator string, left, right querypb.Type) querypb.Type {
+	switch operator {
+	case sqlparser.BitAndStr, sqlparser.BitOrStr, sqlparser.BitXorStr, sqlparser.ShiftLeftStr, sqlparser.ShiftRightStr:
+		return querypb.Type_UINT64
+	case sqlparser.IntDivStr:
+		return querypb.Type_INT64
+	}
+
+	isDouble := func(typ querypb.Type) bool {
+		return sqltypes.IsFloat(typ) || sqltypes.IsQuoted(typ)
+	}
+	switch {
+	case isDouble(left) || isDouble(right):
+		return querypb.Type_FLOAT64
+	case operator == sqlparser.DivStr:
+		return querypb.Type_DECIMAL
+	case sqltypes.IsIntegral(left) && sqltypes.IsIntegral(right):
+		if sqltypes.IsUnsigned(left) || sqltypes.IsUnsigned(right) {
+			return querypb.Type_UINT64
+		}
+		return querypb.Type_INT64
+	}
+	return querypb.Type_DECIMAL
 }
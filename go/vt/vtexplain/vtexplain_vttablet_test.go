@@ -18,9 +18,23 @@ package vtexplain
 
 import (
 	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"golang.org/x/net/context"
+
+	"github.com/youtube/vitess/go/mysql"
+	"github.com/youtube/vitess/go/sqltypes"
+	"github.com/youtube/vitess/go/sync2"
+	"github.com/youtube/vitess/go/vt/sqlparser"
+	"github.com/youtube/vitess/go/vt/vterrors"
+
+	querypb "github.com/youtube/vitess/go/vt/proto/query"
 	topodatapb "github.com/youtube/vitess/go/vt/proto/topodata"
+	vtrpcpb "github.com/youtube/vitess/go/vt/proto/vtrpc"
 )
 
 func TestParseSchema(t *testing.T) {
@@ -40,9 +54,12 @@ create table t2 (
 	if err != nil {
 		t.Fatalf("parseSchema: %v", err)
 	}
-	initTabletEnvironment(ddls, defaultTestOpts())
+	env, err := newTabletEnvironment(ddls, defaultTestOpts())
+	if err != nil {
+		t.Fatalf("newTabletEnvironment: %v", err)
+	}
 
-	tablet := newTablet(&topodatapb.Tablet{
+	tablet := newTablet(env, &topodatapb.Tablet{
 		Keyspace: "test_keyspace",
 		Shard:    "-80",
 	})
@@ -83,3 +100,2831 @@ create table t2 (
 		t.Errorf("expected !HasPrimary && t2.PKColumns == [] got %v", t2.PKColumns)
 	}
 }
+
+// initTestTablet sets up the tablet environment for the given schema and
+// returns a tablet that can be used to run queries against it.
+func initTestTablet(t *testing.T, schema string, opts *Options) *explainTablet {
+	ddls, err := parseSchema(schema)
+	if err != nil {
+		t.Fatalf("parseSchema: %v", err)
+	}
+	env, err := newTabletEnvironment(ddls, opts)
+	if err != nil {
+		t.Fatalf("newTabletEnvironment: %v", err)
+	}
+
+	return newTablet(env, &topodatapb.Tablet{
+		Keyspace: "test_keyspace",
+		Shard:    "-80",
+	})
+}
+
+// handleTestQuery runs the given query through the tablet's fake query
+// handler and returns the result.
+func handleTestQuery(tablet *explainTablet, query string) (*sqltypes.Result, error) {
+	var result *sqltypes.Result
+	err := tablet.HandleQuery(nil, query, func(r *sqltypes.Result) error {
+		result = r
+		return nil
+	})
+	return result, err
+}
+
+func TestHandleQueryJoin(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	name varchar(64),
+	primary key (id)
+);
+
+create table t2 (
+	id bigint(20) unsigned not null,
+	t1_id bigint(20) unsigned not null,
+	info varchar(64),
+	primary key (id)
+);
+`, defaultTestOpts())
+
+	query := "select a.id, name, b.id, info from t1 as a join t2 as b on a.id = b.t1_id"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	wantTypes := []querypb.Type{sqltypes.Uint64, sqltypes.VarChar, sqltypes.Uint64, sqltypes.VarChar}
+	if len(result.Fields) != len(wantTypes) || len(result.Rows) != 1 {
+		t.Fatalf("HandleQuery(%s): got %v", query, result)
+	}
+	for i, want := range wantTypes {
+		if got := result.Fields[i].Type; got != want {
+			t.Errorf("HandleQuery(%s): field %d type %v, want %v", query, i, got, want)
+		}
+	}
+
+	errTests := []struct {
+		query string
+		err   string
+	}{{
+		query: "select id from t1 join t2 on t1.id = t2.t1_id",
+		err:   "column id is ambiguous in t1 and t2 in select id from t1 join t2 on t1.id = t2.t1_id",
+	}, {
+		query: "select c.id from t1 join t2 on t1.id = t2.t1_id",
+		err:   "unable to resolve table name c in select c.id from t1 join t2 on t1.id = t2.t1_id",
+	}, {
+		query: "select t1.info from t1 join t2 on t1.id = t2.t1_id",
+		err:   "invalid column t1.info (did you mean t2.info?) in select t1.info from t1 join t2 on t1.id = t2.t1_id",
+	}, {
+		query: "select a.info from t1 as a join t2 as b on a.id = b.t1_id",
+		err:   "invalid column a.info (did you mean b.info?) in select a.info from t1 as a join t2 as b on a.id = b.t1_id",
+	}, {
+		query: "select t1_id from t1",
+		err:   "invalid column t1_id (did you mean t2.t1_id?) in select t1_id from t1",
+	}, {
+		query: "select foo from t1",
+		err:   "invalid column foo in select foo from t1",
+	}, {
+		query: "select convert from t1",
+		err:   "syntax error at position 20 near 'from' in select convert from t1",
+	}}
+	for _, tcase := range errTests {
+		_, err = handleTestQuery(tablet, tcase.query)
+		if err == nil || err.Error() != tcase.err {
+			t.Errorf("HandleQuery(%s): %v, want %s", tcase.query, err, tcase.err)
+		}
+	}
+}
+
+func TestHandleQueryStar(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	name varchar(64),
+	created datetime,
+	score int,
+	info varchar(64),
+	primary key (id)
+);
+
+create table t2 (
+	id bigint(20) unsigned not null,
+	t1_id bigint(20) unsigned not null,
+	primary key (id)
+);
+`, defaultTestOpts())
+
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"select * from t1", []string{"id", "name", "created", "score", "info"}},
+		{"select * from t2 join t1 on t1.id = t2.t1_id", []string{"id", "t1_id", "id", "name", "created", "score", "info"}},
+		{"select b.*, a.id from t1 as a join t2 as b on a.id = b.t1_id", []string{"id", "t1_id", "id"}},
+		{"select * from (select info, id from t1) as d", []string{"info", "id"}},
+	}
+	for _, tcase := range tests {
+		// the order must not depend on map iteration
+		for i := 0; i < 5; i++ {
+			result, err := handleTestQuery(tablet, tcase.query)
+			if err != nil {
+				t.Fatalf("HandleQuery(%s): %v", tcase.query, err)
+			}
+			var got []string
+			for _, field := range result.Fields {
+				got = append(got, field.Name)
+			}
+			if !reflect.DeepEqual(got, tcase.want) {
+				t.Errorf("HandleQuery(%s): fields %v, want %v", tcase.query, got, tcase.want)
+				break
+			}
+		}
+	}
+}
+
+func TestHandleQueryFuncTypes(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	name varchar(64),
+	price float,
+	primary key (id)
+);
+`, defaultTestOpts())
+
+	tests := []struct {
+		query string
+		want  querypb.Type
+	}{
+		{"select count(*) from t1", sqltypes.Int64},
+		{"select sum(price) from t1", sqltypes.Decimal},
+		{"select avg(id) from t1", sqltypes.Decimal},
+		{"select max(name) from t1", sqltypes.VarChar},
+		{"select min(id) from t1", sqltypes.Uint64},
+		{"select concat(name, 'x') from t1", sqltypes.VarChar},
+		{"select length(name) from t1", sqltypes.Int64},
+		{"select foo(id) from t1", sqltypes.Int32},
+	}
+	for _, tcase := range tests {
+		result, err := handleTestQuery(tablet, tcase.query)
+		if err != nil {
+			t.Errorf("HandleQuery(%s): %v", tcase.query, err)
+			continue
+		}
+		if got := result.Fields[0].Type; got != tcase.want {
+			t.Errorf("HandleQuery(%s): type %v, want %v", tcase.query, got, tcase.want)
+		}
+		if got := result.Rows[0][0].Type(); got != tcase.want {
+			t.Errorf("HandleQuery(%s): value type %v, want %v", tcase.query, got, tcase.want)
+		}
+	}
+}
+
+func TestHandleQueryArithmeticTypes(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table orders (
+	id bigint(20) unsigned not null,
+	quantity int not null,
+	price decimal(10,2) not null,
+	weight float not null,
+	primary key (id)
+);
+`, defaultTestOpts())
+
+	tests := []struct {
+		query string
+		name  string
+		want  querypb.Type
+	}{
+		{"select price * quantity from orders", "price * quantity", sqltypes.Decimal},
+		{"select quantity + 1 from orders", "quantity + 1", sqltypes.Int64},
+		{"select id - quantity from orders", "id - quantity", sqltypes.Uint64},
+		{"select -id from orders", "-id", sqltypes.Int64},
+		{"select weight * quantity as w from orders", "w", sqltypes.Float64},
+		{"select quantity / 2 from orders", "quantity / 2", sqltypes.Decimal},
+		{"select quantity div 2 from orders", "quantity div 2", sqltypes.Int64},
+		{"select (quantity + 1) * length(id) from orders", "(quantity + 1) * length(id)", sqltypes.Int64},
+	}
+	for _, tcase := range tests {
+		result, err := handleTestQuery(tablet, tcase.query)
+		if err != nil {
+			t.Errorf("HandleQuery(%s): %v", tcase.query, err)
+			continue
+		}
+		if got := result.Fields[0].Type; got != tcase.want {
+			t.Errorf("HandleQuery(%s): type %v, want %v", tcase.query, got, tcase.want)
+		}
+		if result.Fields[0].Name != tcase.name {
+			t.Errorf("HandleQuery(%s): name %s, want %s", tcase.query, result.Fields[0].Name, tcase.name)
+		}
+		if len(result.Rows) != 1 || result.Rows[0][0].Type() != tcase.want {
+			t.Errorf("HandleQuery(%s): rows %v, want a %v value", tcase.query, result.Rows, tcase.want)
+		}
+	}
+}
+
+func TestHandleQueryCaseTypes(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	status varchar(16) not null,
+	quantity int not null,
+	price decimal(10,2) not null,
+	primary key (id)
+);
+`, defaultTestOpts())
+
+	tests := []struct {
+		query string
+		want  querypb.Type
+		null  bool
+	}{
+		{"select case when quantity > 0 then 'yes' else 'no' end from t1", sqltypes.VarChar, false},
+		{"select case status when 'a' then quantity else 0 end from t1", sqltypes.Int32, false},
+		{"select case when quantity > 0 then price else id end from t1", sqltypes.Decimal, false},
+		{"select case when quantity > 0 then quantity end from t1", sqltypes.Int32, false},
+		{"select case when quantity > 0 then null else status end from t1", sqltypes.VarChar, false},
+		{"select case when quantity > 0 then status else quantity end from t1", sqltypes.VarChar, false},
+		{"select case when quantity > 0 then null end from t1", sqltypes.Null, true},
+	}
+	for _, tcase := range tests {
+		result, err := handleTestQuery(tablet, tcase.query)
+		if err != nil {
+			t.Errorf("HandleQuery(%s): %v", tcase.query, err)
+			continue
+		}
+		if got := result.Fields[0].Type; got != tcase.want {
+			t.Errorf("HandleQuery(%s): type %v, want %v", tcase.query, got, tcase.want)
+		}
+		if len(result.Rows) != 1 || result.Rows[0][0].IsNull() != tcase.null {
+			t.Errorf("HandleQuery(%s): rows %v, want null %v", tcase.query, result.Rows, tcase.null)
+		}
+	}
+}
+
+func TestHandleQueryNumRows(t *testing.T) {
+	opts := defaultTestOpts()
+	opts.NumRows = 3
+	opts.RowsPerTable = map[string]int{"t2": 5}
+
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	name varchar(64),
+	primary key (id)
+);
+
+create table t2 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+`, opts)
+
+	tests := []struct {
+		query string
+		want  int
+	}{
+		{"select id, name from t1", 3},
+		{"select id from t2", 5},
+		{"select t2.id, t1.name from t2 join t1 on t1.id = t2.id", 5},
+		{"select id from t2 limit 2", 2},
+		{"select id from t2 order by id desc limit 10", 5},
+		{"select id from t2 limit 3, 10", 2},
+		{"select id from t2 limit 10, 1", 0},
+		{"select id from t2 limit :count", 5},
+		{"update t1 set name = 'foo'", 3},
+		{"delete from t2", 5},
+		{"update t1 set name = 'foo' where id = 1", 1},
+		{"update t1 set name = 'foo' where id in (1, 2)", 2},
+		{"update t1 set name = 'foo' where name in ('a', 'b')", 1},
+		{"delete from t2 where id in (1, 2, 3, 4)", 4},
+		{"delete from t2 where id > 1 and id < 10", 1},
+		{"select id from t2 where id = 3", 1},
+		{"select id from t2 where id in (1, 2)", 2},
+		{"select id from t2 where id in (1, 2) limit 1", 1},
+		{"select id from t2 where id between 2 and 3", 2},
+		{"select id from t2 where id between 1 and 100", 5},
+		{"select id from t2 where id between 'a' and 'b'", 5},
+		{"select id from t2 where id > 1", 5},
+		{"select t2.id, t1.name from t2 join t1 on t1.id = t2.id where t2.id = 1", 5},
+		{"delete from t2 where id between 2 and 3", 2},
+		{"update t1 set name = 'foo' where (id in (1, 2)) and id between 1 and 1", 1},
+		{"select id from t2 where id is null", 0},
+		{"select id from t2 where id is not null", 5},
+		{"select id from t2 where id <=> 2", 1},
+		{"select id from t2 where id <=> null", 0},
+		{"delete from t2 where id is null", 0},
+	}
+	for _, tcase := range tests {
+		result, err := handleTestQuery(tablet, tcase.query)
+		if err != nil {
+			t.Errorf("HandleQuery(%s): %v", tcase.query, err)
+			continue
+		}
+		if int(result.RowsAffected) != tcase.want {
+			t.Errorf("HandleQuery(%s): RowsAffected %d, want %d", tcase.query, result.RowsAffected, tcase.want)
+		}
+		if result.Fields != nil && len(result.Rows) != tcase.want {
+			t.Errorf("HandleQuery(%s): %d rows, want %d", tcase.query, len(result.Rows), tcase.want)
+		}
+	}
+
+	// generated values should differ from row to row, except for the
+	// nullable name which is always NULL
+	result, _ := handleTestQuery(tablet, "select id, name from t1")
+	if result.Rows[0][0].ToString() == result.Rows[1][0].ToString() {
+		t.Errorf("expected distinct values per row, got %v", result.Rows)
+	}
+	for _, row := range result.Rows {
+		if !row[1].IsNull() {
+			t.Errorf("expected NULL names, got %v", result.Rows)
+		}
+	}
+}
+
+func TestHandleQueryTemporalTypes(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	d date,
+	dt datetime,
+	ts timestamp,
+	tm time,
+	primary key (id)
+);
+`, defaultTestOpts())
+
+	query := "select d, dt, ts, tm from t1"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+
+	// The columns are nullable without a default, so the values are NULL
+	// but the fields keep their temporal types.
+	want := []sqltypes.Value{sqltypes.NULL, sqltypes.NULL, sqltypes.NULL, sqltypes.NULL}
+	if !reflect.DeepEqual(result.Rows[0], want) {
+		t.Errorf("HandleQuery(%s): %v, want %v", query, result.Rows[0], want)
+	}
+	wantTypes := []querypb.Type{sqltypes.Date, sqltypes.Datetime, sqltypes.Timestamp, sqltypes.Time}
+	for i, field := range result.Fields {
+		if field.Type != wantTypes[i] {
+			t.Errorf("HandleQuery(%s): field %s type %v, want %v", query, field.Name, field.Type, wantTypes[i])
+		}
+	}
+}
+
+func TestHandleQueryJSON(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	doc json not null,
+	primary key (id)
+);
+`, defaultTestOpts())
+
+	query := "select doc from t1"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	if got, want := result.Fields[0].Type, sqltypes.TypeJSON; got != want {
+		t.Errorf("HandleQuery(%s): field type %v, want %v", query, got, want)
+	}
+	want := sqltypes.MakeTrusted(sqltypes.TypeJSON, []byte("{}"))
+	if !reflect.DeepEqual(result.Rows[0][0], want) {
+		t.Errorf("HandleQuery(%s): %v, want %v", query, result.Rows[0][0], want)
+	}
+
+	query = "describe t1"
+	result, err = handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	if got := result.Rows[1][1].ToString(); got != "json" {
+		t.Errorf("HandleQuery(%s): type %s, want json", query, got)
+	}
+}
+
+func TestHandleQueryUnixTimestamp(t *testing.T) {
+	opts := defaultTestOpts()
+	opts.UnixTimestamp = 1500000000
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	dt datetime not null,
+	y year not null,
+	primary key (id)
+);
+`, opts)
+
+	query := "select unix_timestamp()"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	if got := result.Rows[0][0].ToString(); got != "1500000000" {
+		t.Errorf("HandleQuery(%s): %s, want 1500000000", query, got)
+	}
+
+	query = "select dt, y from t1"
+	result, err = handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	want := []sqltypes.Value{
+		sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2017-07-14 02:40:00")),
+		sqltypes.MakeTrusted(sqltypes.Year, []byte("2017")),
+	}
+	if !reflect.DeepEqual(result.Rows[0], want) {
+		t.Errorf("HandleQuery(%s): %v, want %v", query, result.Rows[0], want)
+	}
+}
+
+func TestHandleQueryDefaults(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	intval bigint(20) not null default 7,
+	strval varchar(64) default 'foo',
+	nullval varchar(64) default null,
+	nullable varchar(64),
+	notnull varchar(64) not null,
+	primary key (id)
+);
+`, defaultTestOpts())
+
+	query := "select id, intval, strval, nullval, nullable, notnull from t1"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+
+	want := []sqltypes.Value{
+		sqltypes.MakeTrusted(sqltypes.Uint64, []byte("1")),
+		sqltypes.MakeTrusted(sqltypes.Int64, []byte("7")),
+		sqltypes.MakeTrusted(sqltypes.VarChar, []byte("foo")),
+		sqltypes.NULL,
+		sqltypes.NULL,
+		sqltypes.NewVarChar("notnull_val_6"),
+	}
+	if !reflect.DeepEqual(result.Rows[0], want) {
+		t.Errorf("HandleQuery(%s): %v, want %v", query, result.Rows[0], want)
+	}
+}
+
+func TestShowCreateTable(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	name varchar(64) default 'foo',
+	primary key (id),
+	key name_idx (name)
+);
+`, defaultTestOpts())
+
+	query := "show create table t1"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+
+	want := "create table t1 (\n" +
+		"\tid bigint(20) unsigned not null,\n" +
+		"\tname varchar(64) default 'foo',\n" +
+		"\tprimary key (id),\n" +
+		"\tkey name_idx (name)\n" +
+		")"
+	if len(result.Rows) != 1 || result.Rows[0][0].ToString() != "t1" || result.Rows[0][1].ToString() != want {
+		t.Errorf("HandleQuery(%s): %v, want %s", query, result.Rows, want)
+	}
+}
+
+func TestHandleQueryEnum(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	status enum('active', 'inactive') not null,
+	tags set('red', 'green') not null,
+	primary key (id)
+);
+`, defaultTestOpts())
+
+	query := "select status, tags from t1"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	want := sqltypes.MakeTrusted(sqltypes.Enum, []byte("active"))
+	if result.Fields[0].Type != sqltypes.Enum || !reflect.DeepEqual(result.Rows[0][0], want) {
+		t.Errorf("HandleQuery(%s): %v %v, want %v", query, result.Fields, result.Rows, want)
+	}
+	want = sqltypes.MakeTrusted(sqltypes.Set, []byte("red"))
+	if result.Fields[1].Type != sqltypes.Set || !reflect.DeepEqual(result.Rows[0][1], want) {
+		t.Errorf("HandleQuery(%s): %v %v, want %v", query, result.Fields, result.Rows, want)
+	}
+
+	query = "describe t1"
+	result, err = handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	if got, want := result.Rows[1][1].ToString(), "enum('active','inactive')"; got != want {
+		t.Errorf("HandleQuery(%s): type %s, want %s", query, got, want)
+	}
+	if got, want := result.Rows[2][1].ToString(), "set('red','green')"; got != want {
+		t.Errorf("HandleQuery(%s): type %s, want %s", query, got, want)
+	}
+}
+
+func TestHandleQueryUnion(t *testing.T) {
+	opts := defaultTestOpts()
+	opts.NumRows = 2
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	name varchar(64) not null,
+	primary key (id)
+);
+
+create table t2 (
+	id bigint(20) unsigned not null,
+	info varchar(64) not null,
+	primary key (id)
+);
+`, opts)
+
+	tests := []struct {
+		query string
+		rows  int
+	}{
+		{"select id, name from t1 union select id, info from t2", 2},
+		{"select id, name from t1 union all select id, info from t2", 4},
+		{"select id, name from t1 union all select id, info from t2 union all select id, info from t2", 6},
+	}
+	for _, tcase := range tests {
+		result, err := handleTestQuery(tablet, tcase.query)
+		if err != nil {
+			t.Errorf("HandleQuery(%s): %v", tcase.query, err)
+			continue
+		}
+		if len(result.Fields) != 2 || result.Fields[0].Name != "id" || result.Fields[1].Name != "name" {
+			t.Errorf("HandleQuery(%s): fields %v", tcase.query, result.Fields)
+		}
+		if len(result.Rows) != tcase.rows {
+			t.Errorf("HandleQuery(%s): %d rows, want %d", tcase.query, len(result.Rows), tcase.rows)
+		}
+	}
+
+	query := "select id, name from t1 union select id from t2"
+	want := "the used select statements have a different number of columns: select id, name from t1 union select id from t2"
+	_, err := handleTestQuery(tablet, query)
+	if err == nil || err.Error() != want {
+		t.Errorf("HandleQuery(%s): %v, want %s", query, err, want)
+	}
+}
+
+func TestHandleQueryDerivedTable(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	name varchar(64) not null,
+	primary key (id)
+);
+`, defaultTestOpts())
+
+	query := "select x, d.name from (select id as x, name from t1) as d"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	wantFields := []*querypb.Field{{
+		Name: "x",
+		Type: sqltypes.Uint64,
+	}, {
+		Name: "name",
+		Type: sqltypes.VarChar,
+	}}
+	if !reflect.DeepEqual(result.Fields, wantFields) || len(result.Rows) != 1 {
+		t.Errorf("HandleQuery(%s): %v, want fields %v", query, result, wantFields)
+	}
+
+	query = "select id from (select id as x from t1) as d"
+	want := "invalid column id (did you mean t1.id?) in select id from (select id as x from t1) as d"
+	_, err = handleTestQuery(tablet, query)
+	if err == nil || err.Error() != want {
+		t.Errorf("HandleQuery(%s): %v, want %s", query, err, want)
+	}
+}
+
+func TestHandleQueryAutoIncrement(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null auto_increment,
+	name varchar(64),
+	primary key (id)
+);
+
+create table t2 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+`, defaultTestOpts())
+
+	tests := []struct {
+		query        string
+		rowsAffected uint64
+		insertID     uint64
+	}{
+		{"insert into t1(name) values ('a')", 1, 1},
+		{"insert into t1(name) values ('b'), ('c')", 2, 2},
+		{"insert into t1(name) values ('d')", 1, 4},
+		{"insert into t2(id) values (10)", 1, 0},
+		{"truncate table t1", 0, 0},
+		{"insert into t1(name) values ('e')", 1, 1},
+		{"truncate t2", 0, 0},
+	}
+	for _, tcase := range tests {
+		result, err := handleTestQuery(tablet, tcase.query)
+		if err != nil {
+			t.Errorf("HandleQuery(%s): %v", tcase.query, err)
+			continue
+		}
+		if result.RowsAffected != tcase.rowsAffected || result.InsertID != tcase.insertID {
+			t.Errorf("HandleQuery(%s): RowsAffected %d InsertID %d, want %d %d", tcase.query, result.RowsAffected, result.InsertID, tcase.rowsAffected, tcase.insertID)
+		}
+	}
+
+	// a new tablet starts counting from scratch
+	tablet = newTablet(tablet.env, &topodatapb.Tablet{
+		Keyspace: "test_keyspace",
+		Shard:    "80-",
+	})
+	result, _ := handleTestQuery(tablet, "insert into t1(name) values ('a')")
+	if result.InsertID != 1 {
+		t.Errorf("expected InsertID 1 on a new tablet, got %d", result.InsertID)
+	}
+
+	query := "truncate table t3"
+	want := "unable to resolve table name t3 in truncate table t3"
+	if _, err := handleTestQuery(tablet, query); err == nil || err.Error() != want {
+		t.Errorf("HandleQuery(%s): %v, want %s", query, err, want)
+	}
+}
+
+func TestTabletReset(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null auto_increment,
+	name varchar(64),
+	primary key (id)
+);
+`, defaultTestOpts())
+	tablet.env.batchTime = sync2.NewBatcher(10 * time.Millisecond)
+	if len(tablet.mysqlQueries) != 0 {
+		t.Errorf("new tablet: mysql queries %v, want none", tablet.mysqlQueries)
+	}
+
+	target := &querypb.Target{
+		Keyspace:   "test_keyspace",
+		Shard:      "-80",
+		TabletType: topodatapb.TabletType_MASTER,
+	}
+	sql := "insert into t1(name) values ('a')"
+	if _, err := tablet.Execute(context.Background(), target, sql, nil, 0, nil); err != nil {
+		t.Fatalf("Execute(%s): %v", sql, err)
+	}
+	query := "set @@session.sql_mode = 'ANSI_QUOTES'"
+	if _, err := handleTestQuery(tablet, query); err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+
+	tablet.Reset()
+	if len(tablet.tabletQueries) != 0 || len(tablet.mysqlQueries) != 0 || tablet.currentTime != 0 {
+		t.Errorf("Reset: tablet queries %v, mysql queries %v, time %d, want none", tablet.tabletQueries, tablet.mysqlQueries, tablet.currentTime)
+	}
+
+	query = "select @@sql_mode"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	if got, want := result.Rows[0][0].ToString(), "STRICT_TRANS_TABLES"; got != want {
+		t.Errorf("HandleQuery(%s) after Reset: %s, want %s", query, got, want)
+	}
+	query = "insert into t1(name) values ('b')"
+	result, err = handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	if result.InsertID != 1 {
+		t.Errorf("HandleQuery(%s) after Reset: InsertID %d, want 1", query, result.InsertID)
+	}
+}
+
+func TestHandleQueryReplace(t *testing.T) {
+	schema := `
+create table t1 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+`
+	tests := []struct {
+		replaceConflict bool
+		query           string
+		rowsAffected    uint64
+	}{
+		{false, "replace into t1(id) values (1)", 1},
+		{false, "replace into t1(id) values (1), (2)", 2},
+		{true, "replace into t1(id) values (1)", 2},
+		{true, "replace into t1(id) values (1), (2)", 4},
+		{true, "insert into t1(id) values (1)", 1},
+	}
+	for _, tcase := range tests {
+		opts := defaultTestOpts()
+		opts.ReplaceConflict = tcase.replaceConflict
+		tablet := initTestTablet(t, schema, opts)
+
+		result, err := handleTestQuery(tablet, tcase.query)
+		if err != nil {
+			t.Errorf("HandleQuery(%s): %v", tcase.query, err)
+			continue
+		}
+		if result.RowsAffected != tcase.rowsAffected {
+			t.Errorf("HandleQuery(%s) with ReplaceConflict %v: RowsAffected %d, want %d", tcase.query, tcase.replaceConflict, result.RowsAffected, tcase.rowsAffected)
+		}
+	}
+}
+
+func TestHandleQueryOnDuplicateKey(t *testing.T) {
+	schema := `
+create table t1 (
+	id bigint(20) unsigned not null,
+	val bigint(20),
+	primary key (id)
+);
+`
+	tests := []struct {
+		duplicateKeyConflict bool
+		query                string
+		rowsAffected         uint64
+	}{
+		{false, "insert into t1(id, val) values (1, 1) on duplicate key update val = 1", 1},
+		{false, "insert into t1(id, val) values (1, 1), (2, 2) on duplicate key update val = values(val)", 2},
+		{true, "insert into t1(id, val) values (1, 1) on duplicate key update val = 1", 2},
+		{true, "insert into t1(id, val) values (1, 1), (2, 2) on duplicate key update val = values(val)", 4},
+		{true, "insert into t1(id, val) values (1, 1)", 1},
+	}
+	for _, tcase := range tests {
+		opts := defaultTestOpts()
+		opts.DuplicateKeyConflict = tcase.duplicateKeyConflict
+		tablet := initTestTablet(t, schema, opts)
+
+		result, err := handleTestQuery(tablet, tcase.query)
+		if err != nil {
+			t.Errorf("HandleQuery(%s): %v", tcase.query, err)
+			continue
+		}
+		if result.RowsAffected != tcase.rowsAffected {
+			t.Errorf("HandleQuery(%s) with DuplicateKeyConflict %v: RowsAffected %d, want %d", tcase.query, tcase.duplicateKeyConflict, result.RowsAffected, tcase.rowsAffected)
+		}
+	}
+}
+
+func TestHandleQueryStrictNotNull(t *testing.T) {
+	schema := `
+create table t1 (
+	id bigint(20) unsigned not null auto_increment,
+	name varchar(64) not null,
+	state varchar(64) not null default 'new',
+	email varchar(64),
+	primary key (id)
+);
+`
+	opts := defaultTestOpts()
+	opts.StrictNotNull = true
+	tablet := initTestTablet(t, schema, opts)
+
+	tests := []struct {
+		query string
+		err   string
+	}{
+		{"insert into t1(name) values ('a')", ""},
+		{"insert into t1(id, name, state, email) values (1, 'a', 'b', 'c')", ""},
+		{"insert into t1 values (1, 'a', 'b', 'c')", ""},
+		{"insert into t1(email) values ('c')", "Field 'name' doesn't have a default value (errno 1364) (sqlstate HY000)"},
+		{"update t1 set email = 'c'", ""},
+	}
+	for _, tcase := range tests {
+		_, err := handleTestQuery(tablet, tcase.query)
+		got := ""
+		if err != nil {
+			got = err.Error()
+		}
+		if got != tcase.err {
+			t.Errorf("HandleQuery(%s): %v, want %v", tcase.query, got, tcase.err)
+		}
+	}
+
+	// Without the option, missing columns get their implicit values.
+	tablet = initTestTablet(t, schema, defaultTestOpts())
+	query := "insert into t1(email) values ('c')"
+	if _, err := handleTestQuery(tablet, query); err != nil {
+		t.Errorf("HandleQuery(%s): %v", query, err)
+	}
+}
+
+func TestHandleQueryErrorQueries(t *testing.T) {
+	opts := defaultTestOpts()
+	opts.ErrorQueries = map[string]string{
+		"select .* from t1 where id = 5.*": "simulated failure",
+	}
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+`, opts)
+
+	query := "select id from t1 where id = 5 limit 10001"
+	_, err := handleTestQuery(tablet, query)
+	want := "simulated failure (errno 1105) (sqlstate HY000)"
+	if err == nil || err.Error() != want {
+		t.Errorf("HandleQuery(%s): %v, want %s", query, err, want)
+	}
+	if len(tablet.mysqlQueries) != 1 {
+		t.Errorf("expected the failed query to be recorded, got %v", tablet.mysqlQueries)
+	}
+
+	query = "select id from t1 where id = 6"
+	if _, err := handleTestQuery(tablet, query); err != nil {
+		t.Errorf("HandleQuery(%s): %v", query, err)
+	}
+
+	opts.ErrorQueries = map[string]string{"select (": "bad pattern"}
+	ddls, _ := parseSchema("create table t1 (id bigint)")
+	if _, err := newTabletEnvironment(ddls, opts); err == nil {
+		t.Errorf("expected an error for an invalid pattern")
+	}
+}
+
+func TestHandleQueryInformationSchema(t *testing.T) {
+	opts := defaultTestOpts()
+	opts.RowsPerTable = map[string]int{"t2": 5}
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	name varchar(64) default 'none',
+	primary key (id)
+);
+
+create table t2 (
+	id int not null,
+	primary key (id)
+);
+`, opts)
+
+	tests := []struct {
+		query string
+		want  [][]string
+	}{{
+		"select column_name, is_nullable, data_type, column_key from information_schema.columns where table_schema = 'vt_explain' and table_name = 't1'",
+		[][]string{{"id", "NO", "bigint", "PRI"}, {"name", "YES", "varchar", ""}},
+	}, {
+		"select column_type as type, column_default from information_schema.columns where table_name = 't1' and column_name = 'name'",
+		[][]string{{"varchar(64)", "none"}},
+	}, {
+		"select table_name, table_rows from information_schema.tables",
+		[][]string{{"t1", "1"}, {"t2", "5"}},
+	}, {
+		"select table_name from INFORMATION_SCHEMA.TABLES where table_name = 'nonexistent'",
+		[][]string{},
+	}, {
+		"select column_name from information_schema.columns where table_name = 't1' and column_default is null",
+		[][]string{{"id"}},
+	}, {
+		"select column_name from information_schema.columns where table_name = 't1' and column_default is not null",
+		[][]string{{"name"}},
+	}}
+	for _, tcase := range tests {
+		result, err := handleTestQuery(tablet, tcase.query)
+		if err != nil {
+			t.Errorf("HandleQuery(%s): %v", tcase.query, err)
+			continue
+		}
+		got := make([][]string, 0, len(result.Rows))
+		for _, row := range result.Rows {
+			var values []string
+			for _, val := range row {
+				values = append(values, val.ToString())
+			}
+			got = append(got, values)
+		}
+		if !reflect.DeepEqual(got, tcase.want) {
+			t.Errorf("HandleQuery(%s): %v, want %v", tcase.query, got, tcase.want)
+		}
+	}
+
+	query := "select * from information_schema.statistics"
+	if _, err := handleTestQuery(tablet, query); err == nil {
+		t.Errorf("HandleQuery(%s): expected error", query)
+	}
+}
+
+func TestShowFullColumns(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	name varchar(64) default 'none',
+	code varchar(8) character set latin1,
+	tag varchar(8) collate utf8mb4_bin,
+	primary key (id)
+);
+`, defaultTestOpts())
+
+	query := "show full columns from t1"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	if len(result.Fields) != 9 {
+		t.Errorf("HandleQuery(%s): %d fields, want 9", query, len(result.Fields))
+	}
+	got := make([][]string, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		got = append(got, []string{row[0].ToString(), row[2].ToString(), row[3].ToString(), row[5].ToString()})
+	}
+	want := [][]string{
+		{"id", "", "NO", ""},
+		{"name", "utf8_general_ci", "YES", "none"},
+		{"code", "latin1_swedish_ci", "YES", ""},
+		{"tag", "utf8mb4_bin", "YES", ""},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("HandleQuery(%s): %v, want %v", query, got, want)
+	}
+
+	query = "select column_name, character_set_name from information_schema.columns where table_name = 't1' and column_name = 'tag'"
+	result, err = handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	if len(result.Rows) != 1 || result.Rows[0][1].ToString() != "utf8mb4" {
+		t.Errorf("HandleQuery(%s): %v, want charset utf8mb4", query, result.Rows)
+	}
+}
+
+func TestCollations(t *testing.T) {
+	opts := defaultTestOpts()
+	opts.NumRows = 5
+	opts.Collations = map[string]string{
+		"t2":      "utf8_bin",
+		"t3.code": "latin1_general_cs",
+	}
+	tablet := initTestTablet(t, `
+create table t1 (
+	name varchar(64) not null,
+	primary key (name)
+);
+
+create table t2 (
+	name varchar(64) not null,
+	primary key (name)
+);
+
+create table t3 (
+	code varchar(8) not null,
+	tag varchar(8) collate utf8_unicode_ci,
+	primary key (code)
+);
+`, opts)
+
+	tests := []struct {
+		query string
+		want  int
+	}{
+		{"select name from t1 where name in ('a', 'A', 'b')", 2},
+		{"select name from t1 where name in ('a', 'a', :name)", 2},
+		{"select name from t2 where name in ('a', 'A', 'b')", 3},
+		{"select code from t3 where code in ('a', 'A')", 2},
+	}
+	for _, tcase := range tests {
+		result, err := handleTestQuery(tablet, tcase.query)
+		if err != nil {
+			t.Errorf("HandleQuery(%s): %v", tcase.query, err)
+			continue
+		}
+		if len(result.Rows) != tcase.want {
+			t.Errorf("HandleQuery(%s): %d rows, want %d", tcase.query, len(result.Rows), tcase.want)
+		}
+	}
+
+	query := "show full columns from t3"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	got := make([][]string, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		got = append(got, []string{row[0].ToString(), row[2].ToString()})
+	}
+	want := [][]string{
+		{"code", "latin1_general_cs"},
+		{"tag", "utf8_unicode_ci"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("HandleQuery(%s): %v, want %v", query, got, want)
+	}
+
+	for _, collations := range []map[string]string{
+		{"unknown": "utf8_bin"},
+		{"t1.unknown": "utf8_bin"},
+		{"t1.id": "utf8_bin"},
+		{"t1": ""},
+	} {
+		ddls, err := parseSchema("create table t1 (id bigint(20) unsigned not null, primary key (id))")
+		if err != nil {
+			t.Fatalf("parseSchema: %v", err)
+		}
+		opts := defaultTestOpts()
+		opts.Collations = collations
+		if _, err := newTabletEnvironment(ddls, opts); err == nil {
+			t.Errorf("newTabletEnvironment(%v): expected error", collations)
+		}
+	}
+}
+
+func TestShowColumns(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	name varchar(64) default 'none',
+	name_id bigint(20),
+	primary key (id)
+);
+`, defaultTestOpts())
+
+	for _, tcase := range []struct {
+		query   string
+		fields  int
+		columns []string
+	}{
+		{"show columns from t1", len(mysql.DescribeTableFields), []string{"id", "name", "name_id"}},
+		{"SHOW COLUMNS FROM `t1` LIKE 'name%'", len(mysql.DescribeTableFields), []string{"name", "name_id"}},
+		{"show fields in t1 like 'ID'", len(mysql.DescribeTableFields), []string{"id"}},
+		{"show columns from t1 like 'name\\_id'", len(mysql.DescribeTableFields), []string{"name_id"}},
+		{"show columns from t1 like 'n_me'", len(mysql.DescribeTableFields), []string{"name"}},
+		{"show columns from t1 like 'other%'", len(mysql.DescribeTableFields), nil},
+		{"show full columns from t1 like '%id'", len(showFullColumnsFields), []string{"id", "name_id"}},
+	} {
+		result, err := handleTestQuery(tablet, tcase.query)
+		if err != nil {
+			t.Errorf("HandleQuery(%s): %v", tcase.query, err)
+			continue
+		}
+		if len(result.Fields) != tcase.fields {
+			t.Errorf("HandleQuery(%s): %d fields, want %d", tcase.query, len(result.Fields), tcase.fields)
+		}
+		var columns []string
+		for _, row := range result.Rows {
+			columns = append(columns, row[0].ToString())
+		}
+		if !reflect.DeepEqual(columns, tcase.columns) || int(result.RowsAffected) != len(tcase.columns) {
+			t.Errorf("HandleQuery(%s): columns %v, want %v", tcase.query, columns, tcase.columns)
+		}
+	}
+
+	query := "show columns from t2"
+	want := "unable to resolve table name t2 in show columns from t2"
+	if _, err := handleTestQuery(tablet, query); err == nil || err.Error() != want {
+		t.Errorf("HandleQuery(%s): %v, want %s", query, err, want)
+	}
+}
+
+func TestParseTableCharset(t *testing.T) {
+	tests := []struct {
+		options   string
+		charset   string
+		collation string
+	}{
+		{"", "utf8", "utf8_general_ci"},
+		{" ENGINE=InnoDB", "utf8", "utf8_general_ci"},
+		{" ENGINE=InnoDB DEFAULT CHARSET=latin1", "latin1", "latin1_swedish_ci"},
+		{" DEFAULT CHARACTER SET=utf8mb4 COLLATE=utf8mb4_unicode_ci", "utf8mb4", "utf8mb4_unicode_ci"},
+		{" COLLATE utf8mb4_bin", "utf8mb4", "utf8mb4_bin"},
+	}
+	for _, tcase := range tests {
+		charset, collation := parseTableCharset(tcase.options)
+		if charset != tcase.charset || collation != tcase.collation {
+			t.Errorf("parseTableCharset(%q): %s %s, want %s %s", tcase.options, charset, collation, tcase.charset, tcase.collation)
+		}
+	}
+}
+
+func TestShowTableStatus(t *testing.T) {
+	opts := defaultTestOpts()
+	opts.RowsPerTable = map[string]int{"t1": 3}
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null auto_increment,
+	primary key (id)
+);
+
+create table t2 (
+	id int not null,
+	primary key (id)
+);
+`, opts)
+
+	query := "show table status"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	if len(result.Rows) != 2 {
+		t.Fatalf("HandleQuery(%s): got %d rows, want 2", query, len(result.Rows))
+	}
+
+	tests := []struct {
+		row           []sqltypes.Value
+		name          string
+		rows          string
+		autoIncrement string
+	}{
+		{result.Rows[0], "t1", "3", "1"},
+		{result.Rows[1], "t2", "1", ""},
+	}
+	for _, tcase := range tests {
+		if got := tcase.row[0].ToString(); got != tcase.name {
+			t.Errorf("Name: %s, want %s", got, tcase.name)
+		}
+		if got := tcase.row[1].ToString(); got != "InnoDB" {
+			t.Errorf("%s Engine: %s, want InnoDB", tcase.name, got)
+		}
+		if got := tcase.row[4].ToString(); got != tcase.rows {
+			t.Errorf("%s Rows: %s, want %s", tcase.name, got, tcase.rows)
+		}
+		if got := tcase.row[10].ToString(); got != tcase.autoIncrement {
+			t.Errorf("%s Auto_increment: %s, want %s", tcase.name, got, tcase.autoIncrement)
+		}
+	}
+
+	query = "show table status like 't2'"
+	result, err = handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	if len(result.Rows) != 1 || result.Rows[0][0].ToString() != "t2" {
+		t.Errorf("HandleQuery(%s): %v, want a single row for t2", query, result.Rows)
+	}
+}
+
+func TestHandleQueryGeneratedColumns(t *testing.T) {
+	opts := defaultTestOpts()
+	opts.StrictNotNull = true
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	a int not null,
+	b int not null,
+	c int generated always as (a + b) stored not null,
+	primary key (id)
+);
+`, opts)
+
+	query := "describe t1"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	if got, want := result.Rows[3][5].ToString(), "STORED GENERATED"; got != want {
+		t.Errorf("HandleQuery(%s): extra %s, want %s", query, got, want)
+	}
+
+	query = "select c from t1"
+	result, err = handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	if result.Fields[0].Type != sqltypes.Int32 {
+		t.Errorf("HandleQuery(%s): type %v, want %v", query, result.Fields[0].Type, sqltypes.Int32)
+	}
+
+	for _, query := range []string{
+		"insert into t1(id, a, b) values (1, 2, 3)",
+		"update t1 set a = 2 where id = 1",
+	} {
+		if _, err := handleTestQuery(tablet, query); err != nil {
+			t.Errorf("HandleQuery(%s): %v", query, err)
+		}
+	}
+
+	for _, query := range []string{
+		"insert into t1(id, a, b, c) values (1, 2, 3, 5)",
+		"update t1 set c = 2 where id = 1",
+	} {
+		if _, err := handleTestQuery(tablet, query); err == nil {
+			t.Errorf("HandleQuery(%s): expected error", query)
+		}
+	}
+}
+
+func TestForeignKeys(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+
+create table t2 (
+	id bigint(20) unsigned not null,
+	t1_id bigint(20) unsigned not null,
+	parent_id bigint(20) unsigned,
+	primary key (id),
+	foreign key (t1_id) references t1 (id),
+	constraint t2_parent foreign key (parent_id) references t2 (id)
+);
+`, defaultTestOpts())
+
+	query := "select constraint_name, column_name, referenced_table_name, referenced_column_name from information_schema.key_column_usage where table_name = 't2'"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	got := make([][]string, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		var values []string
+		for _, val := range row {
+			values = append(values, val.ToString())
+		}
+		got = append(got, values)
+	}
+	want := [][]string{
+		{"PRIMARY", "id", "", ""},
+		{"t2_ibfk_1", "t1_id", "t1", "id"},
+		{"t2_parent", "parent_id", "t2", "id"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("HandleQuery(%s): %v, want %v", query, got, want)
+	}
+
+	query = "show create table t2"
+	result, err = handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	if createTable := result.Rows[0][1].ToString(); !strings.Contains(createTable, "foreign key (t1_id) references t1 (id)") {
+		t.Errorf("HandleQuery(%s): %s, want the foreign key", query, createTable)
+	}
+
+	testcases := []struct {
+		schema string
+		err    string
+	}{{
+		schema: "create table t2 (id int, t1_id int, primary key (id), foreign key (t3_id) references t1 (id))",
+		err:    "invalid schema: foreign key on table t2 references unknown column t3_id",
+	}, {
+		schema: "create table t2 (id int, t1_id int, primary key (id), foreign key (id, t1_id) references t1 (id))",
+		err:    "invalid schema: foreign key on table t2 references 1 columns of table t1 for 2 columns",
+	}}
+	for _, tcase := range testcases {
+		ddls, err := parseSchema(tcase.schema)
+		if err != nil {
+			t.Fatalf("parseSchema(%s): %v", tcase.schema, err)
+		}
+		if _, err := newTabletEnvironment(ddls, defaultTestOpts()); err == nil || err.Error() != tcase.err {
+			t.Errorf("newTabletEnvironment(%s): %v, want %s", tcase.schema, err, tcase.err)
+		}
+	}
+}
+
+func TestHandleQueryMultiStatements(t *testing.T) {
+	opts := defaultTestOpts()
+	opts.MultiStatements = true
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	name varchar(64) not null,
+	primary key (id)
+);
+`, opts)
+
+	query := "insert into t1(id, name) values (1, 'a'); select name from t1;"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	if len(result.Fields) != 1 || result.Fields[0].Name != "name" {
+		t.Errorf("HandleQuery(%s): fields %v, want the select result", query, result.Fields)
+	}
+
+	want := []*MysqlQuery{
+		{Time: 0, SQL: "insert into t1(id, name) values (1, 'a')"},
+		{Time: 0, SQL: "select name from t1"},
+	}
+	if !reflect.DeepEqual(tablet.mysqlQueries, want) {
+		t.Errorf("mysqlQueries: %v, want %v", tablet.mysqlQueries, want)
+	}
+
+	query = "insert into t1(id, name) values (2, 'b'); select unknown from t1"
+	if _, err := handleTestQuery(tablet, query); err == nil {
+		t.Errorf("HandleQuery(%s): expected error", query)
+	}
+}
+
+func TestRecordInsertValues(t *testing.T) {
+	opts := defaultTestOpts()
+	opts.RecordInsertValues = true
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	name varchar(64) not null,
+	primary key (id)
+);
+`, opts)
+	tablet.env.batchTime = sync2.NewBatcher(10 * time.Millisecond)
+
+	target := &querypb.Target{
+		Keyspace:   "test_keyspace",
+		Shard:      "-80",
+		TabletType: topodatapb.TabletType_MASTER,
+	}
+	sql := "insert into t1(id, name) values (:_id0, 'a'), (:_id1, :vtg1)"
+	bindVars := map[string]*querypb.BindVariable{
+		"_id0": sqltypes.Int64BindVariable(1),
+		"_id1": sqltypes.Int64BindVariable(2),
+		"vtg1": sqltypes.StringBindVariable("b"),
+	}
+	if _, err := tablet.Execute(context.Background(), target, sql, bindVars, 0, nil); err != nil {
+		t.Fatalf("Execute(%s): %v", sql, err)
+	}
+	want := []map[string]string{
+		{"id": "1", "name": "'a'"},
+		{"id": "2", "name": "'b'"},
+	}
+	if len(tablet.tabletQueries) != 1 || !reflect.DeepEqual(tablet.tabletQueries[0].InsertValues, want) {
+		t.Errorf("Execute(%s): tablet queries %v, want insert values %v", sql, tablet.tabletQueries, want)
+	}
+	var got []map[string]string
+	for _, q := range tablet.mysqlQueries {
+		if strings.HasPrefix(q.SQL, "insert") {
+			got = q.InsertValues
+		}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Execute(%s): mysql insert values %v, want %v", sql, got, want)
+	}
+
+	testCases := []struct {
+		query string
+		want  []map[string]string
+	}{
+		{"insert into t1 values (3, 'c', null)", []map[string]string{{"id": "3", "name": "'c'", "3": "null"}}},
+		{"replace into t1(id, name) values (4, concat('d', 'e'))", []map[string]string{{"id": "4", "name": "concat('d', 'e')"}}},
+		{"insert into t1(id, name) select id, name from t1", nil},
+		{"select id, name from t1", nil},
+	}
+	for _, tc := range testCases {
+		tablet.mysqlQueries = nil
+		if _, err := handleTestQuery(tablet, tc.query); err != nil {
+			t.Fatalf("HandleQuery(%s): %v", tc.query, err)
+		}
+		if got := tablet.mysqlQueries[0].InsertValues; !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("HandleQuery(%s): insert values %v, want %v", tc.query, got, tc.want)
+		}
+	}
+
+	tablet.env.recordInsertValues = false
+	tablet.mysqlQueries = nil
+	query := "insert into t1(id, name) values (5, 'f')"
+	if _, err := handleTestQuery(tablet, query); err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	if got := tablet.mysqlQueries[0].InsertValues; got != nil {
+		t.Errorf("HandleQuery(%s): insert values %v, want none", query, got)
+	}
+}
+
+func TestTableAccesses(t *testing.T) {
+	testcases := []struct {
+		query  string
+		reads  []string
+		writes []string
+	}{{
+		query: "select t1.id from t1 join t2 on t1.id = t2.id",
+		reads: []string{"t1", "t2"},
+	}, {
+		query:  "insert into t2(id) select id from t1",
+		reads:  []string{"t1"},
+		writes: []string{"t2"},
+	}, {
+		query:  "update t1 set a = 1 where id in (select id from t2)",
+		reads:  []string{"t2"},
+		writes: []string{"t1"},
+	}, {
+		query:  "delete t1 from t1 join t2 on t1.id = t2.id",
+		reads:  []string{"t2"},
+		writes: []string{"t1"},
+	}, {
+		query: "begin",
+	}, {
+		query: "not a query",
+	}}
+	for _, tcase := range testcases {
+		reads, writes := tableAccesses(tcase.query)
+		if !reflect.DeepEqual(reads, tcase.reads) || !reflect.DeepEqual(writes, tcase.writes) {
+			t.Errorf("tableAccesses(%s): %v %v, want %v %v", tcase.query, reads, writes, tcase.reads, tcase.writes)
+		}
+	}
+}
+
+func TestHandleQueryColumnValues(t *testing.T) {
+	opts := defaultTestOpts()
+	opts.NumRows = 3
+	opts.ColumnValues = map[string][]string{
+		"t1.id":   {"10", "20"},
+		"t1.name": {"alice", "bob", "carol"},
+	}
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	name varchar(64),
+	info varchar(64) not null,
+	primary key (id)
+);
+`, opts)
+
+	query := "select a.id, name, info from t1 as a"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	var got [][]string
+	for _, row := range result.Rows {
+		got = append(got, []string{row[0].ToString(), row[1].ToString(), row[2].ToString()})
+	}
+	want := [][]string{
+		{"10", "alice", "info_val_3"},
+		{"20", "bob", "info_val_6"},
+		{"10", "carol", "info_val_9"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("HandleQuery(%s): %v, want %v", query, got, want)
+	}
+
+	for _, columnValues := range []map[string][]string{
+		{"t1.unknown": {"a"}},
+		{"t1": {"a"}},
+		{"t1.id": {"abc"}},
+	} {
+		ddls, err := parseSchema("create table t1 (id bigint(20) unsigned not null, primary key (id))")
+		if err != nil {
+			t.Fatalf("parseSchema: %v", err)
+		}
+		opts := defaultTestOpts()
+		opts.ColumnValues = columnValues
+		if _, err := newTabletEnvironment(ddls, opts); err == nil {
+			t.Errorf("newTabletEnvironment(%v): expected error", columnValues)
+		}
+	}
+}
+
+func TestHandleQueryDistinct(t *testing.T) {
+	opts := defaultTestOpts()
+	opts.NumRows = 5
+	opts.ColumnValues = map[string][]string{
+		"t1.name": {"alice", "bob"},
+	}
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	name varchar(64) not null,
+	dt date not null,
+	primary key (id)
+);
+`, opts)
+
+	testCases := []struct {
+		query string
+		want  []string
+	}{
+		{"select distinct dt from t1", []string{"2020-01-01"}},
+		{"select distinct name from t1", []string{"alice", "bob"}},
+		{"select distinct name from t1 limit 1, 5", []string{"bob"}},
+		{"select distinct name, dt from t1", []string{"alice", "bob"}},
+		{"select name from t1 limit 3", []string{"alice", "bob", "alice"}},
+	}
+	for _, tc := range testCases {
+		result, err := handleTestQuery(tablet, tc.query)
+		if err != nil {
+			t.Fatalf("HandleQuery(%s): %v", tc.query, err)
+		}
+		var got []string
+		for _, row := range result.Rows {
+			got = append(got, row[0].ToString())
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("HandleQuery(%s): %v, want %v", tc.query, got, tc.want)
+		}
+		if result.RowsAffected != uint64(len(tc.want)) {
+			t.Errorf("HandleQuery(%s): RowsAffected %d, want %d", tc.query, result.RowsAffected, len(tc.want))
+		}
+	}
+
+	query := "select distinct name, dt from t1"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	wantFields := []*querypb.Field{
+		{Name: "name", Type: sqltypes.VarChar},
+		{Name: "dt", Type: sqltypes.Date},
+	}
+	if !reflect.DeepEqual(result.Fields, wantFields) {
+		t.Errorf("HandleQuery(%s): fields %v, want %v", query, result.Fields, wantFields)
+	}
+
+	tablet.env.batchTime = sync2.NewBatcher(10 * time.Millisecond)
+	target := &querypb.Target{
+		Keyspace:   "test_keyspace",
+		Shard:      "-80",
+		TabletType: topodatapb.TabletType_MASTER,
+	}
+	for _, sql := range []string{"select distinct name from t1", "select name from t1"} {
+		tablet.tabletQueries = nil
+		if _, err := tablet.Execute(context.Background(), target, sql, nil, 0, nil); err != nil {
+			t.Fatalf("Execute(%s): %v", sql, err)
+		}
+		want := strings.HasPrefix(sql, "select distinct")
+		if len(tablet.tabletQueries) != 1 || tablet.tabletQueries[0].Distinct != want {
+			t.Errorf("Execute(%s): tablet queries %v, want Distinct %v", sql, tablet.tabletQueries, want)
+		}
+	}
+}
+
+// fkValueGenerator generates the same keys for the ids of t1 and the
+// t1_id references to them, and describes the source of other values.
+type fkValueGenerator struct{}
+
+func (fkValueGenerator) Generate(table, column string, typ querypb.Type, rowIndex int) (sqltypes.Value, error) {
+	if (table == "t1" && column == "id") || (table == "t2" && column == "t1_id") {
+		return sqltypes.NewValue(typ, []byte(fmt.Sprintf("%d", 100+rowIndex)))
+	}
+	return sqltypes.NewVarChar(fmt.Sprintf("%s:%s:%d", table, column, rowIndex)), nil
+}
+
+func TestHandleQueryValueGenerator(t *testing.T) {
+	opts := defaultTestOpts()
+	opts.NumRows = 2
+	opts.ValueGenerator = fkValueGenerator{}
+	opts.ColumnValues = map[string][]string{
+		"t2.note": {"seeded"},
+	}
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	name varchar(64) not null,
+	primary key (id)
+);
+
+create table t2 (
+	id bigint(20) unsigned not null,
+	t1_id bigint(20) unsigned not null,
+	note varchar(64) not null,
+	primary key (id)
+);
+`, opts)
+
+	testCases := []struct {
+		query string
+		want  [][]string
+	}{
+		{"select id, name as n, id + 1 from t1", [][]string{{"100", "t1:name:0", ":id + 1:0"}, {"101", "t1:name:1", ":id + 1:1"}}},
+		{"select * from t2", [][]string{{"t2:id:0", "100", "seeded"}, {"t2:id:1", "101", "seeded"}}},
+	}
+	for _, tc := range testCases {
+		result, err := handleTestQuery(tablet, tc.query)
+		if err != nil {
+			t.Fatalf("HandleQuery(%s): %v", tc.query, err)
+		}
+		var got [][]string
+		for _, row := range result.Rows {
+			var values []string
+			for _, v := range row {
+				values = append(values, v.ToString())
+			}
+			got = append(got, values)
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("HandleQuery(%s): %v, want %v", tc.query, got, tc.want)
+		}
+	}
+}
+
+func TestHandleQueryColumnTypeOverrides(t *testing.T) {
+	opts := defaultTestOpts()
+	opts.ColumnTypeOverrides = map[string]querypb.Type{
+		"t1.val": sqltypes.Int64,
+	}
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	val varchar(64) not null,
+	primary key (id)
+);
+`, opts)
+
+	query := "select val from t1"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	if got := result.Fields[0].Type; got != sqltypes.Int64 {
+		t.Errorf("HandleQuery(%s): field type %v, want %v", query, got, sqltypes.Int64)
+	}
+	if got := result.Rows[0][0]; got.Type() != sqltypes.Int64 {
+		t.Errorf("HandleQuery(%s): value %v, want an %v", query, got, sqltypes.Int64)
+	}
+
+	ddls, err := parseSchema("create table t1 (id bigint(20) unsigned not null, primary key (id))")
+	if err != nil {
+		t.Fatalf("parseSchema: %v", err)
+	}
+	opts = defaultTestOpts()
+	opts.ColumnTypeOverrides = map[string]querypb.Type{"t1.unknown": sqltypes.Int64}
+	if _, err := newTabletEnvironment(ddls, opts); err == nil {
+		t.Errorf("newTabletEnvironment(%v): expected error", opts.ColumnTypeOverrides)
+	}
+}
+
+func TestHandleQueryCall(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+`, defaultTestOpts())
+
+	for _, query := range []string{
+		"call proc()",
+		"/* comment */ CALL proc(1, 'a')",
+	} {
+		result, err := handleTestQuery(tablet, query)
+		if err != nil {
+			t.Errorf("HandleQuery(%s): %v", query, err)
+			continue
+		}
+		if len(result.Fields) != 0 || len(result.Rows) != 0 {
+			t.Errorf("HandleQuery(%s): %v, want an empty result", query, result)
+		}
+	}
+	if len(tablet.mysqlQueries) != 2 {
+		t.Errorf("expected the calls to be recorded, got %v", tablet.mysqlQueries)
+	}
+
+	query := "callproc()"
+	if _, err := handleTestQuery(tablet, query); err == nil {
+		t.Errorf("HandleQuery(%s): expected error", query)
+	}
+}
+
+func TestHandleQueryUtilityStatements(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+`, defaultTestOpts())
+
+	queries := []string{
+		"load data infile '/tmp/t1.csv' into table t1",
+		"/* comment */ LOAD DATA LOCAL INFILE 't1.csv' INTO TABLE t1",
+		"analyze table t1",
+		"optimize table t1",
+		"repair table t1",
+		"check table t1",
+		"checksum table t1",
+		"flush tables",
+		"FLUSH LOGS",
+	}
+	for _, query := range queries {
+		result, err := handleTestQuery(tablet, query)
+		if err != nil {
+			t.Errorf("HandleQuery(%s): %v", query, err)
+			continue
+		}
+		if len(result.Fields) != 0 || len(result.Rows) != 0 || result.RowsAffected != 0 {
+			t.Errorf("HandleQuery(%s): %v, want an empty result", query, result)
+		}
+	}
+	if len(tablet.mysqlQueries) != len(queries) {
+		t.Errorf("expected the statements to be recorded, got %v", tablet.mysqlQueries)
+	}
+
+	query := "loaddata infile 't1.csv' into table t1"
+	if _, err := handleTestQuery(tablet, query); err == nil {
+		t.Errorf("HandleQuery(%s): expected error", query)
+	}
+}
+
+func TestQueryHook(t *testing.T) {
+	type hookCall struct {
+		query string
+		stmt  sqlparser.Statement
+		rows  int
+	}
+	var calls []hookCall
+	opts := defaultTestOpts()
+	opts.NumRows = 2
+	opts.QueryHook = func(query string, stmt sqlparser.Statement, result *sqltypes.Result) error {
+		calls = append(calls, hookCall{query, stmt, len(result.Rows)})
+		if _, ok := stmt.(*sqlparser.Delete); ok {
+			return fmt.Errorf("deletes are not allowed")
+		}
+		return nil
+	}
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+`, opts)
+	if len(calls) != 0 {
+		t.Errorf("new tablet: hook called with %v, want no calls", calls)
+	}
+
+	for _, query := range []string{"select id from t1", "flush tables"} {
+		if _, err := handleTestQuery(tablet, query); err != nil {
+			t.Errorf("HandleQuery(%s): %v", query, err)
+		}
+	}
+	query := "delete from t1"
+	if _, err := handleTestQuery(tablet, query); err == nil || err.Error() != "deletes are not allowed" {
+		t.Errorf("HandleQuery(%s): %v, want the error of the hook", query, err)
+	}
+
+	if len(calls) != 3 {
+		t.Fatalf("hook called with %v, want 3 calls", calls)
+	}
+	if _, ok := calls[0].stmt.(*sqlparser.Select); !ok || calls[0].query != "select id from t1" || calls[0].rows != 2 {
+		t.Errorf("hook called with %v, want the select and its 2 rows", calls[0])
+	}
+	if calls[1].stmt != nil || calls[1].query != "flush tables" {
+		t.Errorf("hook called with %v, want the flush without a statement", calls[1])
+	}
+}
+
+func TestHandleQueryUse(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+`, defaultTestOpts())
+
+	ddls, err := parseSchema("create table t2 (id bigint(20) unsigned not null, primary key (id))")
+	if err != nil {
+		t.Fatalf("parseSchema: %v", err)
+	}
+	otherEnv, err := newTabletEnvironment(ddls, defaultTestOpts())
+	if err != nil {
+		t.Fatalf("newTabletEnvironment: %v", err)
+	}
+
+	testCases := []struct {
+		query   string
+		wantErr string
+	}{
+		{"use test_keyspace", ""},
+		{"use other_keyspace", "Unknown database 'other_keyspace' (errno 1049) (sqlstate HY000)"},
+		{"use", "No database selected (errno 1046) (sqlstate HY000)"},
+		{"select id from t1", ""},
+	}
+	for _, tc := range testCases {
+		_, err := handleTestQuery(tablet, tc.query)
+		if tc.wantErr == "" && err != nil {
+			t.Errorf("HandleQuery(%s): %v", tc.query, err)
+		}
+		if tc.wantErr != "" && (err == nil || err.Error() != tc.wantErr) {
+			t.Errorf("HandleQuery(%s): %v, want %s", tc.query, err, tc.wantErr)
+		}
+	}
+
+	tablet.databaseEnv = func(keyspace string) (*tabletEnv, bool) {
+		return otherEnv, keyspace == "other_keyspace"
+	}
+	for _, query := range []string{"use other_keyspace", "select id from t2"} {
+		if _, err := handleTestQuery(tablet, query); err != nil {
+			t.Errorf("HandleQuery(%s): %v", query, err)
+		}
+	}
+	query := "select id from t1"
+	if _, err := handleTestQuery(tablet, query); err == nil {
+		t.Errorf("HandleQuery(%s) in other_keyspace: expected error", query)
+	}
+
+	// Reset and using the keyspace of the tablet switch back to its tables
+	tablet.Reset()
+	if _, err := handleTestQuery(tablet, query); err != nil {
+		t.Errorf("HandleQuery(%s) after Reset: %v", query, err)
+	}
+	for _, query := range []string{"use other_keyspace", "use test_keyspace", "select id from t1"} {
+		if _, err := handleTestQuery(tablet, query); err != nil {
+			t.Errorf("HandleQuery(%s): %v", query, err)
+		}
+	}
+}
+
+func TestHandleQueryDDL(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+`, defaultTestOpts())
+
+	for _, query := range []string{
+		"alter table t1 add column c int",
+		"create table t2 (id int primary key)",
+		"rename table t1 to t3",
+		"drop table if exists t2",
+	} {
+		result, err := handleTestQuery(tablet, query)
+		if err != nil {
+			t.Errorf("HandleQuery(%s): %v", query, err)
+			continue
+		}
+		if len(result.Fields) != 0 || len(result.Rows) != 0 || result.RowsAffected != 0 {
+			t.Errorf("HandleQuery(%s): %v, want an empty result", query, result)
+		}
+	}
+	if len(tablet.mysqlQueries) != 4 {
+		t.Errorf("expected the schema changes to be recorded, got %v", tablet.mysqlQueries)
+	}
+
+	// The schema changes are not applied
+	for _, query := range []string{"select c from t1", "select id from t2"} {
+		if _, err := handleTestQuery(tablet, query); err == nil {
+			t.Errorf("HandleQuery(%s): expected error", query)
+		}
+	}
+	query := "select id from t1"
+	if _, err := handleTestQuery(tablet, query); err != nil {
+		t.Errorf("HandleQuery(%s): %v", query, err)
+	}
+}
+
+func TestHandleQuerySet(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+`, defaultTestOpts())
+
+	query := "select @@autocommit"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	if got := result.Rows[0][0].ToString(); got != "1" {
+		t.Errorf("HandleQuery(%s): %s, want 1", query, got)
+	}
+
+	for _, query := range []string{
+		"set names utf8",
+		"set @@session.sql_mode = 'NO_ENGINE_SUBSTITUTION'",
+		"set autocommit = 0, wait_timeout = 10",
+		"set @@global.sql_mode = 'ANSI'",
+	} {
+		if _, err := handleTestQuery(tablet, query); err != nil {
+			t.Errorf("HandleQuery(%s): %v", query, err)
+		}
+	}
+
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{"select @@autocommit", "0"},
+		{"select @@session.autocommit", "0"},
+		{"select @@sql_mode", "NO_ENGINE_SUBSTITUTION"},
+		{"select @@global.sql_mode", "ANSI"},
+	}
+	for _, tcase := range tests {
+		result, err := handleTestQuery(tablet, tcase.query)
+		if err != nil {
+			t.Errorf("HandleQuery(%s): %v", tcase.query, err)
+			continue
+		}
+		if got := result.Rows[0][0].ToString(); got != tcase.want {
+			t.Errorf("HandleQuery(%s): %s, want %s", tcase.query, got, tcase.want)
+		}
+	}
+
+	query = "set autocommit = 'maybe'"
+	if _, err := handleTestQuery(tablet, query); err == nil {
+		t.Errorf("HandleQuery(%s): expected error", query)
+	}
+}
+
+func TestHandleQuerySQLMode(t *testing.T) {
+	schema := `
+create table t1 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+`
+	for _, tcase := range []struct {
+		sqlMode string
+		want    string
+	}{
+		{"", "STRICT_TRANS_TABLES"},
+		{"ANSI_QUOTES,NO_ENGINE_SUBSTITUTION", "ANSI_QUOTES,NO_ENGINE_SUBSTITUTION"},
+	} {
+		opts := defaultTestOpts()
+		opts.SQLMode = tcase.sqlMode
+		tablet := initTestTablet(t, schema, opts)
+
+		for _, query := range []string{"select @@global.sql_mode", "select @@sql_mode"} {
+			result, err := handleTestQuery(tablet, query)
+			if err != nil {
+				t.Errorf("HandleQuery(%s): %v", query, err)
+				continue
+			}
+			if got := result.Rows[0][0].ToString(); got != tcase.want {
+				t.Errorf("HandleQuery(%s) with SQLMode %q: %s, want %s", query, tcase.sqlMode, got, tcase.want)
+			}
+		}
+	}
+}
+
+func TestHandleQueryComments(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+`, defaultTestOpts())
+
+	queries := []string{
+		"/* comment */ select @@sql_mode",
+		"/*+ MAX_EXECUTION_TIME(1000) */ select @@sql_mode /* trailing */",
+		"-- comment\nselect @@sql_mode",
+		"  select @@sql_mode  ",
+	}
+	for _, query := range queries {
+		result, err := handleTestQuery(tablet, query)
+		if err != nil {
+			t.Errorf("HandleQuery(%q): %v", query, err)
+			continue
+		}
+		if got, want := result.Rows[0][0].ToString(), "STRICT_TRANS_TABLES"; got != want {
+			t.Errorf("HandleQuery(%q): %s, want %s", query, got, want)
+		}
+	}
+
+	// The session value of a system variable is also found
+	if _, err := handleTestQuery(tablet, "set @@session.sql_mode = 'ANSI_QUOTES'"); err != nil {
+		t.Fatalf("HandleQuery: %v", err)
+	}
+	query := "/* comment */ select @@sql_mode"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%q): %v", query, err)
+	}
+	if got, want := result.Rows[0][0].ToString(), "ANSI_QUOTES"; got != want {
+		t.Errorf("HandleQuery(%q): %s, want %s", query, got, want)
+	}
+
+	// The queries are recorded as they were sent
+	for i, query := range queries {
+		if got := tablet.mysqlQueries[i].SQL; got != query {
+			t.Errorf("mysqlQueries[%d]: %q, want %q", i, got, query)
+		}
+	}
+}
+
+func TestHandleQueryVersion(t *testing.T) {
+	schema := `
+create table t1 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+`
+	for _, tcase := range []struct {
+		mysqlVersion string
+		want         string
+	}{
+		{"", "5.7.20"},
+		{"8.0.3-rc-log", "8.0.3-rc-log"},
+	} {
+		opts := defaultTestOpts()
+		opts.NumRows = 2
+		opts.MysqlVersion = tcase.mysqlVersion
+		tablet := initTestTablet(t, schema, opts)
+
+		for _, query := range []string{
+			"select @@version",
+			"select @@global.version",
+			"select version()",
+			"select id, VERSION() from t1",
+		} {
+			result, err := handleTestQuery(tablet, query)
+			if err != nil {
+				t.Errorf("HandleQuery(%s): %v", query, err)
+				continue
+			}
+			for _, row := range result.Rows {
+				if got := row[len(row)-1].ToString(); got != tcase.want {
+					t.Errorf("HandleQuery(%s) with MysqlVersion %q: %s, want %s", query, tcase.mysqlVersion, got, tcase.want)
+				}
+			}
+			if field := result.Fields[len(result.Fields)-1]; field.Type != sqltypes.VarChar {
+				t.Errorf("HandleQuery(%s): field type %v, want VARCHAR", query, field.Type)
+			}
+		}
+	}
+}
+
+func TestHandleQueryTimeZone(t *testing.T) {
+	schema := `
+create table t1 (
+	id bigint(20) unsigned not null,
+	dt datetime not null,
+	ts timestamp not null,
+	d date not null,
+	primary key (id)
+);
+`
+	for _, tcase := range []struct {
+		timeZone string
+		want     string
+		values   []string
+	}{
+		{"", "SYSTEM", []string{"2020-01-01 00:00:00", "2020-01-01 00:00:00", "2020-01-01"}},
+		{"+05:30", "+05:30", []string{"2020-01-01 05:30:00", "2020-01-01 05:30:00", "2020-01-01"}},
+		{"-08:00", "-08:00", []string{"2019-12-31 16:00:00", "2019-12-31 16:00:00", "2019-12-31"}},
+	} {
+		opts := defaultTestOpts()
+		opts.TimeZone = tcase.timeZone
+		tablet := initTestTablet(t, schema, opts)
+
+		for _, query := range []string{"select @@time_zone", "select @@session.time_zone"} {
+			result, err := handleTestQuery(tablet, query)
+			if err != nil {
+				t.Errorf("HandleQuery(%s): %v", query, err)
+				continue
+			}
+			if got := result.Rows[0][0].ToString(); got != tcase.want {
+				t.Errorf("HandleQuery(%s) with TimeZone %q: %s, want %s", query, tcase.timeZone, got, tcase.want)
+			}
+		}
+
+		query := "select dt, ts, d from t1"
+		result, err := handleTestQuery(tablet, query)
+		if err != nil {
+			t.Errorf("HandleQuery(%s): %v", query, err)
+			continue
+		}
+		var got []string
+		for _, val := range result.Rows[0] {
+			got = append(got, val.ToString())
+		}
+		if !reflect.DeepEqual(got, tcase.values) {
+			t.Errorf("HandleQuery(%s) with TimeZone %q: %v, want %v", query, tcase.timeZone, got, tcase.values)
+		}
+	}
+
+	tablet := initTestTablet(t, schema, defaultTestOpts())
+	if _, err := handleTestQuery(tablet, "set time_zone = '+01:00'"); err != nil {
+		t.Errorf("HandleQuery(set time_zone): %v", err)
+	}
+	query := "select @@time_zone"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	if got := result.Rows[0][0].ToString(); got != "+01:00" {
+		t.Errorf("HandleQuery(%s) after set: %s, want +01:00", query, got)
+	}
+
+	opts := defaultTestOpts()
+	opts.TimeZone = "Nowhere/Special"
+	ddls, _ := parseSchema(schema)
+	if _, err := newTabletEnvironment(ddls, opts); err == nil {
+		t.Errorf("expected an error for an unknown time zone")
+	}
+}
+
+func TestHandleQueryLiteralTypes(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+`, defaultTestOpts())
+
+	tests := []struct {
+		query string
+		want  querypb.Type
+	}{
+		{"select 1 from t1", sqltypes.Int32},
+		{"select 0x1f from t1", sqltypes.Int32},
+		{"select x'1f' from t1", sqltypes.VarBinary},
+		{"select b'101' from t1", sqltypes.Bit},
+		{"select 'a' from t1", sqltypes.VarChar},
+		{"select 1.5 from t1", sqltypes.Float64},
+	}
+	for _, tcase := range tests {
+		result, err := handleTestQuery(tablet, tcase.query)
+		if err != nil {
+			t.Errorf("HandleQuery(%s): %v", tcase.query, err)
+			continue
+		}
+		if got := result.Fields[0].Type; got != tcase.want {
+			t.Errorf("HandleQuery(%s): type %v, want %v", tcase.query, got, tcase.want)
+		}
+		if got := result.Rows[0][0].Type(); got != tcase.want {
+			t.Errorf("HandleQuery(%s): value type %v, want %v", tcase.query, got, tcase.want)
+		}
+	}
+}
+
+func TestHandleQueryCountStar(t *testing.T) {
+	opts := defaultTestOpts()
+	opts.NumRows = 3
+	opts.RowsPerTable = map[string]int{"t2": 42}
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+
+create table t2 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+`, opts)
+
+	tests := []struct {
+		query string
+		name  string
+		want  string
+	}{
+		{"select count(*) from t1", "count(*)", "3"},
+		{"select count(*) as c from t2 where id > 1", "c", "42"},
+		{"select COUNT(*) from t2 join t1 on t1.id = t2.id", "COUNT(*)", "42"},
+	}
+	for _, tcase := range tests {
+		result, err := handleTestQuery(tablet, tcase.query)
+		if err != nil {
+			t.Errorf("HandleQuery(%s): %v", tcase.query, err)
+			continue
+		}
+		if len(result.Rows) != 1 {
+			t.Errorf("HandleQuery(%s): %d rows, want 1", tcase.query, len(result.Rows))
+			continue
+		}
+		if f := result.Fields[0]; f.Name != tcase.name || f.Type != sqltypes.Int64 {
+			t.Errorf("HandleQuery(%s): field %v, want %s INT64", tcase.query, f, tcase.name)
+		}
+		if got := result.Rows[0][0].ToString(); got != tcase.want {
+			t.Errorf("HandleQuery(%s): %s, want %s", tcase.query, got, tcase.want)
+		}
+	}
+
+	// a count with a group by returns a row per group as usual
+	query := "select count(*) from t1 group by id"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	if len(result.Rows) != 3 {
+		t.Errorf("HandleQuery(%s): %d rows, want 3", query, len(result.Rows))
+	}
+}
+
+func TestCompositePrimaryKey(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	a bigint(20) unsigned not null,
+	b varchar(64) not null,
+	c int,
+	primary key (b, a)
+);
+`, defaultTestOpts())
+
+	query := "show index from t1"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	var got [][]string
+	for _, row := range result.Rows {
+		// Key_name, Seq_in_index, Column_name
+		got = append(got, []string{row[2].ToString(), row[3].ToString(), row[4].ToString()})
+	}
+	want := [][]string{
+		{"PRIMARY", "1", "b"},
+		{"PRIMARY", "2", "a"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("HandleQuery(%s): %v, want %v", query, got, want)
+	}
+
+	query = "describe t1"
+	result, err = handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	got = nil
+	for _, row := range result.Rows {
+		got = append(got, []string{row[0].ToString(), row[3].ToString()})
+	}
+	want = [][]string{{"a", "PRI"}, {"b", "PRI"}, {"c", ""}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("HandleQuery(%s): %v, want %v", query, got, want)
+	}
+}
+
+func TestValidateSchema(t *testing.T) {
+	ddls, err := parseSchema(`
+create table t1 (
+	id bigint(20) unsigned not null,
+	name varchar(64) not null,
+	primary key (id),
+	key name_idx (name, nickname)
+);
+
+create table t2 (
+	id bigint(20) unsigned not null,
+	primary key (uid)
+);
+`)
+	if err != nil {
+		t.Fatalf("parseSchema: %v", err)
+	}
+
+	want := "invalid schema: index name_idx on table t1 references unknown column nickname; index PRIMARY on table t2 references unknown column uid"
+	_, err = newTabletEnvironment(ddls, defaultTestOpts())
+	if err == nil || err.Error() != want {
+		t.Errorf("newTabletEnvironment: %v, want %s", err, want)
+	}
+}
+
+func TestHandleQueryDecimal(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	price decimal(10,2) not null,
+	amount decimal(10) not null,
+	primary key (id)
+);
+`, defaultTestOpts())
+
+	query := "select price, amount from t1"
+	result, err := handleTestQuery(tablet, query)
+	if err != nil {
+		t.Fatalf("HandleQuery(%s): %v", query, err)
+	}
+	want := []sqltypes.Value{
+		sqltypes.MakeTrusted(sqltypes.Decimal, []byte("1.00")),
+		sqltypes.MakeTrusted(sqltypes.Decimal, []byte("2")),
+	}
+	if !reflect.DeepEqual(result.Rows[0], want) {
+		t.Errorf("HandleQuery(%s): %v, want %v", query, result.Rows[0], want)
+	}
+}
+
+func TestStreamExecute(t *testing.T) {
+	opts := defaultTestOpts()
+	opts.NumRows = 3
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	name varchar(64),
+	primary key (id)
+);
+`, opts)
+	tablet.env.batchTime = sync2.NewBatcher(10 * time.Millisecond)
+
+	target := &querypb.Target{
+		Keyspace:   "test_keyspace",
+		Shard:      "-80",
+		TabletType: topodatapb.TabletType_MASTER,
+	}
+	sql := "select id, name from t1"
+	var rows [][]sqltypes.Value
+	err := tablet.StreamExecute(context.Background(), target, sql, nil, nil, func(qr *sqltypes.Result) error {
+		rows = append(rows, qr.Rows...)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamExecute(%s): %v", sql, err)
+	}
+	if len(rows) != 3 {
+		t.Errorf("StreamExecute(%s): %d rows, want 3", sql, len(rows))
+	}
+	if len(tablet.tabletQueries) != 1 || tablet.tabletQueries[0].SQL != sql {
+		t.Errorf("StreamExecute(%s): tablet queries %v, want the streamed query", sql, tablet.tabletQueries)
+	}
+}
+
+func TestStreamExecuteBatches(t *testing.T) {
+	opts := defaultTestOpts()
+	opts.NumRows = 5
+	opts.StreamBatchSize = 2
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+`, opts)
+	tablet.env.batchTime = sync2.NewBatcher(10 * time.Millisecond)
+
+	target := &querypb.Target{
+		Keyspace:   "test_keyspace",
+		Shard:      "-80",
+		TabletType: topodatapb.TabletType_MASTER,
+	}
+	for _, tcase := range []struct {
+		sql    string
+		rows   []int
+		fields []bool
+	}{
+		{"select id from t1", []int{2, 2, 1}, []bool{true, false, false}},
+		{"select id from t1 limit 4", []int{2, 2}, []bool{true, false}},
+		{"select id from t1 limit 0", []int{0}, []bool{true}},
+	} {
+		var rows []int
+		var fields []bool
+		err := tablet.StreamExecute(context.Background(), target, tcase.sql, nil, nil, func(qr *sqltypes.Result) error {
+			rows = append(rows, len(qr.Rows))
+			fields = append(fields, qr.Fields != nil)
+			return nil
+		})
+		if err != nil {
+			t.Errorf("StreamExecute(%s): %v", tcase.sql, err)
+			continue
+		}
+		if !reflect.DeepEqual(rows, tcase.rows) || !reflect.DeepEqual(fields, tcase.fields) {
+			t.Errorf("StreamExecute(%s): batches of %v rows with fields %v, want %v and %v", tcase.sql, rows, fields, tcase.rows, tcase.fields)
+		}
+		tq := tablet.tabletQueries[len(tablet.tabletQueries)-1]
+		if tq.Batches != len(tcase.rows) {
+			t.Errorf("StreamExecute(%s): recorded %d batches, want %d", tcase.sql, tq.Batches, len(tcase.rows))
+		}
+	}
+}
+
+func TestMessageStreamAndAck(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table msg (
+	time_scheduled bigint,
+	id bigint,
+	time_next bigint,
+	epoch bigint,
+	time_created bigint,
+	time_acked bigint,
+	message varchar(128),
+	primary key(time_scheduled, id)
+);
+`, defaultTestOpts())
+	tablet.env.batchTime = sync2.NewBatcher(10 * time.Millisecond)
+
+	target := &querypb.Target{
+		Keyspace:   "test_keyspace",
+		Shard:      "-80",
+		TabletType: topodatapb.TabletType_MASTER,
+	}
+	err := tablet.MessageStream(context.Background(), target, "msg", func(qr *sqltypes.Result) error {
+		t.Errorf("MessageStream: unexpected result %v", qr)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("MessageStream: %v", err)
+	}
+
+	ids := []*querypb.Value{{Type: sqltypes.Int64, Value: []byte("1")}, {Type: sqltypes.Int64, Value: []byte("2")}}
+	count, err := tablet.MessageAck(context.Background(), target, "msg", ids)
+	if err != nil {
+		t.Fatalf("MessageAck: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("MessageAck: %d acked, want 0", count)
+	}
+
+	if len(tablet.tabletQueries) != 2 {
+		t.Fatalf("tablet queries %v, want the stream and the ack", tablet.tabletQueries)
+	}
+	if got, want := tablet.tabletQueries[0].SQL, "message stream msg"; got != want {
+		t.Errorf("MessageStream: recorded %s, want %s", got, want)
+	}
+	ack := tablet.tabletQueries[1]
+	if got, want := ack.SQL, "message ack msg"; got != want {
+		t.Errorf("MessageAck: recorded %s, want %s", got, want)
+	}
+	if got := ack.ListSizes["ids"]; got != 2 {
+		t.Errorf("MessageAck: %d ids recorded, want 2", got)
+	}
+}
+
+func TestTransactionIDs(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+`, defaultTestOpts())
+	tablet.env.batchTime = sync2.NewBatcher(10 * time.Millisecond)
+
+	ctx := context.Background()
+	target := &querypb.Target{
+		Keyspace:   "test_keyspace",
+		Shard:      "-80",
+		TabletType: topodatapb.TabletType_MASTER,
+	}
+	if _, err := tablet.Execute(ctx, target, "select id from t1", nil, 0, nil); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	_, transactionID, err := tablet.BeginExecute(ctx, target, "insert into t1(id) values (1)", nil, nil)
+	if err != nil {
+		t.Fatalf("BeginExecute: %v", err)
+	}
+	if _, err := tablet.Execute(ctx, target, "insert into t1(id) values (2)", nil, transactionID, nil); err != nil {
+		t.Fatalf("Execute: %v", err)
+	}
+	if err := tablet.Commit(ctx, target, transactionID); err != nil {
+		t.Fatalf("Commit: %v", err)
+	}
+
+	want := []int64{0, transactionID, transactionID}
+	var got []int64
+	for _, tq := range tablet.tabletQueries {
+		got = append(got, tq.TransactionID)
+	}
+	if transactionID == 0 || !reflect.DeepEqual(got, want) {
+		t.Errorf("transaction ids %v, want %v", got, want)
+	}
+}
+
+func TestExecuteDeadline(t *testing.T) {
+	opts := defaultTestOpts()
+	opts.QueryLatency = time.Second
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+`, opts)
+	tablet.env.batchTime = sync2.NewBatcher(10 * time.Millisecond)
+
+	target := &querypb.Target{
+		Keyspace:   "test_keyspace",
+		Shard:      "-80",
+		TabletType: topodatapb.TabletType_MASTER,
+	}
+	sql := "select id from t1"
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	short, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	long, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	for _, tcase := range []struct {
+		name string
+		ctx  context.Context
+		code vtrpcpb.Code
+	}{
+		{"no deadline", context.Background(), vtrpcpb.Code_OK},
+		{"canceled", canceled, vtrpcpb.Code_CANCELED},
+		{"deadline before the latency", short, vtrpcpb.Code_DEADLINE_EXCEEDED},
+		{"deadline after the latency", long, vtrpcpb.Code_OK},
+	} {
+		_, err := tablet.Execute(tcase.ctx, target, sql, nil, 0, nil)
+		if code := vterrors.Code(err); code != tcase.code {
+			t.Errorf("Execute with %s: %v, want code %v", tcase.name, err, tcase.code)
+		}
+		_, _, err = tablet.BeginExecute(tcase.ctx, target, sql, nil, nil)
+		if code := vterrors.Code(err); code != tcase.code {
+			t.Errorf("BeginExecute with %s: %v, want code %v", tcase.name, err, tcase.code)
+		}
+	}
+
+	// the queries are recorded even if they time out
+	if len(tablet.tabletQueries) != 8 {
+		t.Errorf("%d tablet queries, want 8", len(tablet.tabletQueries))
+	}
+}
+
+func TestShardLatencies(t *testing.T) {
+	schema := `
+create table t1 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+`
+	target := &querypb.Target{
+		Keyspace:   "test_keyspace",
+		Shard:      "-80",
+		TabletType: topodatapb.TabletType_MASTER,
+	}
+	sql := "select id from t1"
+
+	// queryTimes returns the times of queries run by a new tablet, which
+	// gets a new time simulator so that the first query is sent at 1.
+	queryTimes := func(opts *Options) []int {
+		tablet := initTestTablet(t, schema, opts)
+		tablet.env.batchTime = sync2.NewBatcher(time.Millisecond)
+		var times []int
+		for i := 0; i < 5; i++ {
+			if _, err := tablet.Execute(context.Background(), target, sql, nil, 0, nil); err != nil {
+				t.Fatalf("Execute(%s): %v", sql, err)
+			}
+			times = append(times, tablet.tabletQueries[i].Time)
+		}
+		return times
+	}
+
+	opts := defaultTestOpts()
+	opts.ShardLatencies = map[string]ShardLatency{"-80": {Base: 2}, "80-": {Base: 5}}
+	if got, want := queryTimes(opts), []int{3, 6, 9, 12, 15}; !reflect.DeepEqual(got, want) {
+		t.Errorf("query times with a latency of 2: %v, want %v", got, want)
+	}
+
+	opts.ShardLatencies = map[string]ShardLatency{"-80": {Base: 1, Jitter: 10}}
+	opts.LatencySeed = 42
+	got := queryTimes(opts)
+	for i := 1; i < len(got); i++ {
+		if delta := got[i] - got[i-1]; delta < 2 || delta > 12 {
+			t.Errorf("query times with a latency of 1 to 11: %v", got)
+			break
+		}
+	}
+	if again := queryTimes(opts); !reflect.DeepEqual(again, got) {
+		t.Errorf("query times with the same seed: %v, then %v", got, again)
+	}
+
+	opts.ShardLatencies = map[string]ShardLatency{"-80": {Base: -1}}
+	ddls, _ := parseSchema(schema)
+	if _, err := newTabletEnvironment(ddls, opts); err == nil {
+		t.Errorf("expected an error for a negative latency")
+	}
+}
+
+func TestBindVarTypeChecks(t *testing.T) {
+	schema := `
+create table t1 (
+	id bigint(20) unsigned not null,
+	name varchar(64),
+	created datetime,
+	primary key (id)
+);
+
+create table t2 (
+	id bigint(20) unsigned not null,
+	t1_id bigint(20),
+	primary key (id)
+);
+`
+	opts := defaultTestOpts()
+	opts.CheckBindVarTypes = true
+	tablet := initTestTablet(t, schema, opts)
+	tablet.env.batchTime = sync2.NewBatcher(10 * time.Millisecond)
+
+	target := &querypb.Target{
+		Keyspace:   "test_keyspace",
+		Shard:      "-80",
+		TabletType: topodatapb.TabletType_MASTER,
+	}
+	for _, tcase := range []struct {
+		sql      string
+		bindVars map[string]*querypb.BindVariable
+		err      string
+	}{{
+		sql:      "select name from t1 where id = :id",
+		bindVars: map[string]*querypb.BindVariable{"id": sqltypes.Int64BindVariable(5)},
+	}, {
+		sql:      "select name from t1 where id = :id",
+		bindVars: map[string]*querypb.BindVariable{"id": sqltypes.StringBindVariable("5")},
+		err:      "bind variable id of type VARBINARY doesn't match column t1.id of type UINT64",
+	}, {
+		sql:      "select id from t1 where name = :name",
+		bindVars: map[string]*querypb.BindVariable{"name": sqltypes.Int64BindVariable(5)},
+		err:      "bind variable name of type INT64 doesn't match column t1.name of type VARCHAR",
+	}, {
+		// temporal columns are compared with numbers and strings alike
+		sql:      "select id from t1 where created = :created",
+		bindVars: map[string]*querypb.BindVariable{"created": sqltypes.Int64BindVariable(20200101)},
+	}, {
+		sql:      "select a.id from t1 as a join t2 on a.id = t2.t1_id where :t1_id = t2.t1_id",
+		bindVars: map[string]*querypb.BindVariable{"t1_id": sqltypes.StringBindVariable("5")},
+		err:      "bind variable t1_id of type VARBINARY doesn't match column t2.t1_id of type INT64",
+	}, {
+		// id is ambiguous, so it is left to mysql
+		sql:      "select name from t1 join t2 where id = :id",
+		bindVars: map[string]*querypb.BindVariable{"id": sqltypes.StringBindVariable("5")},
+	}, {
+		sql:      "update t1 set name = 'x' where id = :id",
+		bindVars: map[string]*querypb.BindVariable{"id": sqltypes.StringBindVariable("5")},
+		err:      "bind variable id of type VARBINARY doesn't match column t1.id of type UINT64",
+	}} {
+		_, err := tablet.Execute(context.Background(), target, tcase.sql, tcase.bindVars, 0, nil)
+		if tcase.err == "" {
+			if vterrors.Code(err) == vtrpcpb.Code_INVALID_ARGUMENT {
+				t.Errorf("Execute(%s, %v): %v, want no type error", tcase.sql, tcase.bindVars, err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != tcase.err || vterrors.Code(err) != vtrpcpb.Code_INVALID_ARGUMENT {
+			t.Errorf("Execute(%s, %v): %v, want %s", tcase.sql, tcase.bindVars, err, tcase.err)
+		}
+	}
+
+	// the checks are opt-in
+	tablet = initTestTablet(t, schema, defaultTestOpts())
+	tablet.env.batchTime = sync2.NewBatcher(10 * time.Millisecond)
+	sql := "select name from t1 where id = :id"
+	if _, err := tablet.Execute(context.Background(), target, sql, map[string]*querypb.BindVariable{"id": sqltypes.StringBindVariable("5")}, 0, nil); err != nil {
+		t.Errorf("Execute(%s) without type checks: %v", sql, err)
+	}
+}
+
+func TestBindVarTypes(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	name varchar(64),
+	primary key (id)
+);
+`, defaultTestOpts())
+	tablet.env.batchTime = sync2.NewBatcher(10 * time.Millisecond)
+
+	target := &querypb.Target{
+		Keyspace:   "test_keyspace",
+		Shard:      "-80",
+		TabletType: topodatapb.TabletType_MASTER,
+	}
+	sql := "select name from t1 as a where a.id = :id and :name = name and name like :pattern"
+	bindVars := map[string]*querypb.BindVariable{
+		"id":      sqltypes.Uint64BindVariable(1),
+		"name":    sqltypes.StringBindVariable("a"),
+		"pattern": sqltypes.StringBindVariable("a%"),
+	}
+	if _, err := tablet.Execute(context.Background(), target, sql, bindVars, 0, nil); err != nil {
+		t.Fatalf("Execute(%s): %v", sql, err)
+	}
+	want := BindVarTypes{"id": sqltypes.Uint64, "name": sqltypes.VarChar}
+	if len(tablet.tabletQueries) != 1 || !reflect.DeepEqual(tablet.tabletQueries[0].BindVarTypes, want) {
+		t.Errorf("Execute(%s): tablet queries %v, want bind var types %v", sql, tablet.tabletQueries, want)
+	}
+	out, err := json.Marshal(tablet.tabletQueries[0])
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+	if wantJSON := `"BindVarTypes":{"id":"UINT64","name":"VARCHAR"}`; !strings.Contains(string(out), wantJSON) {
+		t.Errorf("json.Marshal: %s, want %s", out, wantJSON)
+	}
+
+	testCases := []struct {
+		query string
+		want  BindVarTypes
+	}{
+		{"select name from t1 where id = :v1", BindVarTypes{"v1": sqltypes.Uint64}},
+		{"update t1 set name = :v1 where id <=> :v2", BindVarTypes{"v2": sqltypes.Uint64}},
+		{"select name from t1 where id = 1", nil},
+		{"select name from t1 where unknown = :v1", nil},
+		{"insert into t1(id) values (:v1)", nil},
+	}
+	for _, tc := range testCases {
+		tablet.mysqlQueries = nil
+		handleTestQuery(tablet, tc.query)
+		if got := tablet.mysqlQueries[0].BindVarTypes; !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("HandleQuery(%s): bind var types %v, want %v", tc.query, got, tc.want)
+		}
+	}
+}
+
+func TestExecuteBatch(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+`, defaultTestOpts())
+	tablet.env.batchTime = sync2.NewBatcher(10 * time.Millisecond)
+
+	target := &querypb.Target{
+		Keyspace:   "test_keyspace",
+		Shard:      "-80",
+		TabletType: topodatapb.TabletType_MASTER,
+	}
+	queries := []*querypb.BoundQuery{
+		{Sql: "insert into t1(id) values (1)"},
+		{Sql: "insert into t1(id) values (2)"},
+	}
+	results, err := tablet.ExecuteBatch(context.Background(), target, queries, true, 0, nil)
+	if err != nil {
+		t.Fatalf("ExecuteBatch: %v", err)
+	}
+	if len(results) != 2 {
+		t.Errorf("ExecuteBatch: %d results, want 2", len(results))
+	}
+
+	if len(tablet.tabletQueries) != 2 {
+		t.Fatalf("ExecuteBatch: %d tablet queries, want 2", len(tablet.tabletQueries))
+	}
+	batchTime := tablet.tabletQueries[0].Time
+	if tablet.tabletQueries[1].Time != batchTime {
+		t.Errorf("ExecuteBatch: queries at times %d and %d, want the same time", batchTime, tablet.tabletQueries[1].Time)
+	}
+
+	// the commit happens after the queries of the batch
+	var commitTime int
+	for _, mq := range tablet.mysqlQueries {
+		if mq.SQL == "commit" {
+			commitTime = mq.Time
+		}
+	}
+	if commitTime <= batchTime {
+		t.Errorf("ExecuteBatch: commit at time %d, want after %d", commitTime, batchTime)
+	}
+}
+
+func TestExecuteBatchRollbackError(t *testing.T) {
+	opts := defaultTestOpts()
+	opts.QueryHook = func(query string, stmt sqlparser.Statement, result *sqltypes.Result) error {
+		switch {
+		case strings.HasPrefix(query, "insert into t1(id) values (2)"):
+			return fmt.Errorf("insert failed")
+		case query == "rollback":
+			return fmt.Errorf("rollback failed")
+		}
+		return nil
+	}
+	tablet := initTestTablet(t, `
+create table t1 (
+	id bigint(20) unsigned not null,
+	primary key (id)
+);
+`, opts)
+
+	target := &querypb.Target{
+		Keyspace:   "test_keyspace",
+		Shard:      "-80",
+		TabletType: topodatapb.TabletType_MASTER,
+	}
+	queries := []*querypb.BoundQuery{
+		{Sql: "insert into t1(id) values (1)"},
+		{Sql: "insert into t1(id) values (2)"},
+	}
+	_, err := tablet.ExecuteBatch(context.Background(), target, queries, true, 0, nil)
+	if err == nil || !strings.Contains(err.Error(), "insert failed") || !strings.Contains(err.Error(), "rollback failed") {
+		t.Errorf("ExecuteBatch: %v, want the errors of the insert and the rollback", err)
+	}
+}
+
+func TestFullScanTables(t *testing.T) {
+	tablet := initTestTablet(t, `
+create table t1 (
+	a bigint(20) unsigned not null,
+	b varchar(64) not null,
+	c int,
+	primary key (b, a),
+	key c_idx (c)
+);
+
+create table t2 (
+	id bigint(20) unsigned not null,
+	t1_a bigint(20) unsigned,
+	primary key (id)
+);
+`, defaultTestOpts())
+
+	testcases := []struct {
+		query string
+		want  []string
+	}{
+		{"select * from t1 limit 10001", []string{"t1"}},
+		{"select * from t1 where b = 'x'", nil},
+		{"select * from t1 where a = 1", []string{"t1"}},
+		{"select * from t1 where c between 1 and 5 and a = 1", nil},
+		{"select * from t1 where b != 'x'", []string{"t1"}},
+		{"select * from t1 where c is null", nil},
+		{"select * from t1 where c is not null", []string{"t1"}},
+		{"select * from t1 where b <=> 'x'", nil},
+		{"select * from t1 where b = 'x' or a = 1", []string{"t1"}},
+		{"select * from t1 x where x.b in ('x', 'y')", nil},
+		{"select * from t1 x where t2.b = 'x'", []string{"t1"}},
+		{"select * from t1 join t2 on t2.t1_a = t1.a where t1.b = 'x'", []string{"t2"}},
+		{"select * from t1 join t2 on t2.id = t1.a where t1.b = 'x'", nil},
+		{"select 1 from dual", nil},
+		{"update t1 set c = 1", nil},
+	}
+	for _, tcase := range testcases {
+		if got := tablet.env.fullScanTables(tcase.query); !reflect.DeepEqual(got, tcase.want) {
+			t.Errorf("fullScanTables(%s): %v, want %v", tcase.query, got, tcase.want)
+		}
+	}
+}
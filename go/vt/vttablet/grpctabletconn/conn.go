@@ -45,8 +45,19 @@ var (
 	name = flag.String("tablet_grpc_server_name", "", "the server name to use to validate server certificate")
 )
 
+// dialProfile is the grpcclient profile of the connections to the query
+// service of the vttablets.
+const dialProfile = "tabletconn"
+
 func init() {
 	tabletconn.RegisterDialer(protocolName, DialTablet)
+	grpcclient.RegisterProfile(dialProfile, func() ([]grpc.DialOption, error) {
+		opt, err := grpcclient.SecureDialOption(*cert, *key, *ca, *name)
+		if err != nil {
+			return nil, err
+		}
+		return []grpc.DialOption{opt}, nil
+	})
 }
 
 // gRPCQueryClient implements a gRPC implementation for QueryService
@@ -69,15 +80,11 @@ func DialTablet(tablet *topodatapb.Tablet, timeout time.Duration) (queryservice.
 	} else {
 		addr = tablet.Hostname
 	}
-	opt, err := grpcclient.SecureDialOption(*cert, *key, *ca, *name)
-	if err != nil {
-		return nil, err
-	}
-	opts := []grpc.DialOption{opt}
+	var opts []grpc.DialOption
 	if timeout > 0 {
 		opts = append(opts, grpc.WithTimeout(timeout))
 	}
-	cc, err := grpcclient.Dial(addr, opts...)
+	cc, err := grpcclient.DialProfile(addr, dialProfile, opts...)
 	if err != nil {
 		return nil, err
 	}
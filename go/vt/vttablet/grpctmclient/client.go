@@ -49,10 +49,21 @@ var (
 	name        = flag.String("tablet_manager_grpc_server_name", "", "the server name to use to validate server certificate")
 )
 
+// dialProfile is the grpcclient profile of the connections to the tablet
+// manager of the vttablets.
+const dialProfile = "tabletmanager"
+
 func init() {
 	tmclient.RegisterTabletManagerClientFactory("grpc", func() tmclient.TabletManagerClient {
 		return NewClient()
 	})
+	grpcclient.RegisterProfile(dialProfile, func() ([]grpc.DialOption, error) {
+		opt, err := grpcclient.SecureDialOption(*cert, *key, *ca, *name)
+		if err != nil {
+			return nil, err
+		}
+		return []grpc.DialOption{opt}, nil
+	})
 }
 
 type tmc struct {
@@ -79,11 +90,7 @@ func NewClient() *Client {
 // dial returns a client to use
 func (client *Client) dial(tablet *topodatapb.Tablet) (*grpc.ClientConn, tabletmanagerservicepb.TabletManagerClient, error) {
 	addr := netutil.JoinHostPort(tablet.Hostname, int32(tablet.PortMap["grpc"]))
-	opt, err := grpcclient.SecureDialOption(*cert, *key, *ca, *name)
-	if err != nil {
-		return nil, nil, err
-	}
-	cc, err := grpcclient.Dial(addr, opt)
+	cc, err := grpcclient.DialProfile(addr, dialProfile)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -92,7 +99,7 @@ func (client *Client) dial(tablet *topodatapb.Tablet) (*grpc.ClientConn, tabletm
 
 func (client *Client) dialPool(tablet *topodatapb.Tablet) (tabletmanagerservicepb.TabletManagerClient, error) {
 	addr := netutil.JoinHostPort(tablet.Hostname, int32(tablet.PortMap["grpc"]))
-	opt, err := grpcclient.SecureDialOption(*cert, *key, *ca, *name)
+	opts, err := grpcclient.ProfileDialOptions(dialProfile)
 	if err != nil {
 		return nil, err
 	}
@@ -108,7 +115,7 @@ func (client *Client) dialPool(tablet *topodatapb.Tablet) (tabletmanagerservicep
 		client.mu.Unlock()
 
 		for i := 0; i < cap(c); i++ {
-			cc, err := grpcclient.Dial(addr, opt)
+			cc, err := grpcclient.Dial(addr, opts...)
 			if err != nil {
 				return nil, err
 			}
@@ -2674,6 +2674,39 @@ func TestTerseErrorsIgnoreFailoverInProgress(t *testing.T) {
 	}
 }
 
+func TestConvertErrorCode(t *testing.T) {
+	testUtils := newTestUtils()
+	config := testUtils.newQueryServiceConfig()
+	tsv := NewTabletServerWithNilTopoServer(config)
+	testcases := []struct {
+		err  error
+		want vtrpcpb.Code
+	}{{
+		err:  mysql.NewSQLError(mysql.ERDupEntry, mysql.SSDupKey, "Duplicate entry '1' for key 'PRIMARY'"),
+		want: vtrpcpb.Code_ALREADY_EXISTS,
+	}, {
+		err:  mysql.NewSQLError(mysql.ERBadNullError, mysql.SSBadNullError, "Column 'name' cannot be null"),
+		want: vtrpcpb.Code_INVALID_ARGUMENT,
+	}, {
+		// mysql returns it in strict mode for inserts that don't set
+		// a not null column without default, which is an error of
+		// the query like the one above.
+		err:  mysql.NewSQLError(mysql.ERNoDefaultForField, mysql.SSUnknownSQLState, "Field 'name' doesn't have a default value"),
+		want: vtrpcpb.Code_INVALID_ARGUMENT,
+	}, {
+		err:  mysql.NewSQLError(mysql.ERLockWaitTimeout, mysql.SSUnknownSQLState, "Lock wait timeout exceeded"),
+		want: vtrpcpb.Code_DEADLINE_EXCEEDED,
+	}, {
+		err:  vterrors.New(vtrpcpb.Code_UNAVAILABLE, "not a mysql error"),
+		want: vtrpcpb.Code_UNAVAILABLE,
+	}}
+	for _, tcase := range testcases {
+		if got := tsv.convertErrorCode(tcase.err); got != tcase.want {
+			t.Errorf("convertErrorCode(%v): %v, want %v", tcase.err, got, tcase.want)
+		}
+	}
+}
+
 func TestConfigChanges(t *testing.T) {
 	db := setUpTabletServerTest(t)
 	defer db.Close()
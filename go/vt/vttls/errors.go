@@ -0,0 +1,163 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vttls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+)
+
+// The inputs of a TLS config, for ConfigError.Input.
+const (
+	InputCert = "cert"
+	InputKey  = "key"
+	InputCA   = "ca"
+)
+
+var (
+	// ErrInvalidPEM is the ConfigError.Err of an input without valid
+	// PEM data.
+	ErrInvalidPEM = errors.New("no valid PEM data")
+
+	// ErrKeyMismatch is the ConfigError.Err of a key that doesn't
+	// match the cert.
+	ErrKeyMismatch = errors.New("private key does not match the cert")
+)
+
+// ConfigError is returned when an input of a TLS config can't be
+// loaded. Err is either the error from reading the file, which can be
+// checked with os.IsNotExist for instance, ErrInvalidPEM, ErrKeyMismatch,
+// or the parsing error.
+type ConfigError struct {
+	// Input is InputCert, InputKey or InputCA.
+	Input string
+	// Path is the file of the input.
+	Path string
+	Err  error
+}
+
+// Error is part of the error interface.
+func (e *ConfigError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("failed to load %v: %v", e.Input, e.Err)
+	}
+	return fmt.Sprintf("failed to load %v %v: %v", e.Input, e.Path, e.Err)
+}
+
+// readInput reads the file of an input.
+func readInput(input, path string) ([]byte, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, &ConfigError{Input: input, Path: path, Err: err}
+	}
+	return b, nil
+}
+
+// loadKeyPair loads a cert and its key from files.
+func loadKeyPair(cert, key string) (tls.Certificate, error) {
+	certPEM, err := readInput(InputCert, cert)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	keyPEM, err := readInput(InputKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return keyPair(certPEM, keyPEM, cert, key)
+}
+
+// keyPair parses a cert and its key, one step at a time so that the
+// error names the input at fault. The paths are only used for errors,
+// and are empty if the data didn't come from files.
+func keyPair(certPEM, keyPEM []byte, cert, key string) (tls.Certificate, error) {
+	certBlock := findPEMBlock(certPEM, func(blockType string) bool {
+		return blockType == "CERTIFICATE"
+	})
+	if certBlock == nil {
+		return tls.Certificate{}, &ConfigError{Input: InputCert, Path: cert, Err: ErrInvalidPEM}
+	}
+	if _, err := x509.ParseCertificate(certBlock.Bytes); err != nil {
+		return tls.Certificate{}, &ConfigError{Input: InputCert, Path: cert, Err: err}
+	}
+
+	keyBlock := findPEMBlock(keyPEM, func(blockType string) bool {
+		return blockType == "PRIVATE KEY" || strings.HasSuffix(blockType, " PRIVATE KEY")
+	})
+	if keyBlock == nil {
+		return tls.Certificate{}, &ConfigError{Input: InputKey, Path: key, Err: ErrInvalidPEM}
+	}
+	if err := parsePrivateKey(keyBlock.Bytes); err != nil {
+		return tls.Certificate{}, &ConfigError{Input: InputKey, Path: key, Err: err}
+	}
+
+	// Both parse, so this can only fail if they don't match.
+	crt, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return tls.Certificate{}, &ConfigError{Input: InputKey, Path: key, Err: ErrKeyMismatch}
+	}
+	return crt, nil
+}
+
+// findPEMBlock returns the first PEM block of data with a type that
+// matches, like tls.X509KeyPair does, or nil if there is none.
+func findPEMBlock(data []byte, match func(blockType string) bool) *pem.Block {
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil || match(block.Type) {
+			return block
+		}
+	}
+}
+
+// parsePrivateKey checks that der is a key in one of the encodings
+// that tls.X509KeyPair accepts.
+func parsePrivateKey(der []byte) error {
+	if _, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return nil
+	}
+	if _, err := x509.ParsePKCS8PrivateKey(der); err == nil {
+		return nil
+	}
+	if _, err := x509.ParseECPrivateKey(der); err == nil {
+		return nil
+	}
+	return errors.New("not a PKCS #1, PKCS #8 or EC private key")
+}
+
+// loadCertPool loads a CA file.
+func loadCertPool(ca string) (*x509.CertPool, error) {
+	b, err := readInput(InputCA, ca)
+	if err != nil {
+		return nil, err
+	}
+	return certPool(b, ca)
+}
+
+// certPool parses CA certs. The path is only used for errors.
+func certPool(caPEM []byte, ca string) (*x509.CertPool, error) {
+	cp := x509.NewCertPool()
+	if !cp.AppendCertsFromPEM(caPEM) {
+		return nil, &ConfigError{Input: InputCA, Path: ca, Err: ErrInvalidPEM}
+	}
+	return cp, nil
+}
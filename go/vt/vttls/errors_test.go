@@ -0,0 +1,176 @@
+/*
+Copyright 2017 Google Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vttls
+
+import (
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/youtube/vitess/go/vt/tlstest"
+)
+
+func TestConfigError(t *testing.T) {
+	root, err := ioutil.TempDir("", "vttls")
+	if err != nil {
+		t.Fatalf("TempDir failed: %v", err)
+	}
+	defer os.RemoveAll(root)
+
+	tlstest.CreateCA(root)
+	tlstest.CreateSignedCert(root, tlstest.CA, "01", "client", "Client")
+	tlstest.CreateSignedCert(root, tlstest.CA, "02", "other", "Other")
+	cert := path.Join(root, "client-cert.pem")
+	key := path.Join(root, "client-key.pem")
+	ca := path.Join(root, "ca-cert.pem")
+	otherKey := path.Join(root, "other-key.pem")
+	missing := path.Join(root, "missing.pem")
+
+	writeFile := func(name string, data []byte) string {
+		p := path.Join(root, name)
+		if err := ioutil.WriteFile(p, data, 0600); err != nil {
+			t.Fatalf("WriteFile failed: %v", err)
+		}
+		return p
+	}
+	notPEM := writeFile("not.pem", []byte("garbage"))
+	badCert := writeFile("bad-cert.pem", pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("garbage")}))
+	badKey := writeFile("bad-key.pem", pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: []byte("garbage")}))
+
+	testcases := []struct {
+		desc         string
+		cert, key    string
+		ca           string
+		input        string
+		path         string
+		fileNotExist bool
+		invalidPEM   bool
+		keyMismatch  bool
+		parseError   bool
+	}{{
+		desc:         "missing cert",
+		cert:         missing,
+		key:          key,
+		input:        InputCert,
+		path:         missing,
+		fileNotExist: true,
+	}, {
+		desc:         "missing key",
+		cert:         cert,
+		key:          missing,
+		input:        InputKey,
+		path:         missing,
+		fileNotExist: true,
+	}, {
+		desc:         "missing ca",
+		ca:           missing,
+		input:        InputCA,
+		path:         missing,
+		fileNotExist: true,
+	}, {
+		desc:       "cert without PEM data",
+		cert:       notPEM,
+		key:        key,
+		input:      InputCert,
+		path:       notPEM,
+		invalidPEM: true,
+	}, {
+		desc:       "key given as the cert",
+		cert:       key,
+		key:        key,
+		input:      InputCert,
+		path:       key,
+		invalidPEM: true,
+	}, {
+		desc:       "cert that doesn't parse",
+		cert:       badCert,
+		key:        key,
+		input:      InputCert,
+		path:       badCert,
+		parseError: true,
+	}, {
+		desc:       "key without PEM data",
+		cert:       cert,
+		key:        notPEM,
+		input:      InputKey,
+		path:       notPEM,
+		invalidPEM: true,
+	}, {
+		desc:       "key that doesn't parse",
+		cert:       cert,
+		key:        badKey,
+		input:      InputKey,
+		path:       badKey,
+		parseError: true,
+	}, {
+		desc:        "mismatched key",
+		cert:        cert,
+		key:         otherKey,
+		input:       InputKey,
+		path:        otherKey,
+		keyMismatch: true,
+	}, {
+		desc:       "ca without PEM data",
+		ca:         notPEM,
+		input:      InputCA,
+		path:       notPEM,
+		invalidPEM: true,
+	}}
+	for _, tcase := range testcases {
+		_, err := ClientConfig(tcase.cert, tcase.key, tcase.ca, "")
+		configErr, ok := err.(*ConfigError)
+		if !ok {
+			t.Errorf("%s: got error %v, want a *ConfigError", tcase.desc, err)
+			continue
+		}
+		if configErr.Input != tcase.input || configErr.Path != tcase.path {
+			t.Errorf("%s: got error for %v %v, want %v %v", tcase.desc, configErr.Input, configErr.Path, tcase.input, tcase.path)
+		}
+		if got := os.IsNotExist(configErr.Err); got != tcase.fileNotExist {
+			t.Errorf("%s: file not exist: %v, want %v (%v)", tcase.desc, got, tcase.fileNotExist, err)
+		}
+		if got := configErr.Err == ErrInvalidPEM; got != tcase.invalidPEM {
+			t.Errorf("%s: invalid PEM: %v, want %v (%v)", tcase.desc, got, tcase.invalidPEM, err)
+		}
+		if got := configErr.Err == ErrKeyMismatch; got != tcase.keyMismatch {
+			t.Errorf("%s: key mismatch: %v, want %v (%v)", tcase.desc, got, tcase.keyMismatch, err)
+		}
+		parseError := !tcase.fileNotExist && !tcase.invalidPEM && !tcase.keyMismatch
+		if parseError != tcase.parseError {
+			t.Errorf("%s: parse error: %v, want %v (%v)", tcase.desc, parseError, tcase.parseError, err)
+		}
+	}
+
+	// The errors of PEM data have no path.
+	certPEM, err := ioutil.ReadFile(cert)
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	_, err = ClientConfigFromPEM(certPEM, []byte("garbage"), nil, "")
+	if configErr, ok := err.(*ConfigError); !ok || configErr.Input != InputKey || configErr.Path != "" || configErr.Err != ErrInvalidPEM {
+		t.Errorf("ClientConfigFromPEM with an invalid key: %v, want ErrInvalidPEM for the key", err)
+	}
+	if got, want := err.Error(), "failed to load key: no valid PEM data"; got != want {
+		t.Errorf("ClientConfigFromPEM error: %q, want %q", got, want)
+	}
+
+	if _, err := ClientConfig(cert, key, ca, ""); err != nil {
+		t.Errorf("ClientConfig with valid files failed: %v", err)
+	}
+}
@@ -18,34 +18,60 @@ package vttls
 
 import (
 	"crypto/tls"
-	"crypto/x509"
-	"fmt"
-	"io/ioutil"
 )
 
 // ClientConfig returns the TLS config to use for a client to
-// connect to a server with the provided parameters.
+// connect to a server with the provided parameters. Failures to load
+// the files are returned as a *ConfigError.
 func ClientConfig(cert, key, ca, name string) (*tls.Config, error) {
 	config := &tls.Config{}
 
 	// Load the client-side cert & key if any.
 	if cert != "" && key != "" {
-		crt, err := tls.LoadX509KeyPair(cert, key)
+		crt, err := loadKeyPair(cert, key)
 		if err != nil {
-			return nil, fmt.Errorf("failed to load cert/key: %v", err)
+			return nil, err
 		}
 		config.Certificates = []tls.Certificate{crt}
 	}
 
 	// Load the server CA if any.
 	if ca != "" {
-		b, err := ioutil.ReadFile(ca)
+		cp, err := loadCertPool(ca)
 		if err != nil {
-			return nil, fmt.Errorf("failed to read ca file: %v", err)
+			return nil, err
 		}
-		cp := x509.NewCertPool()
-		if !cp.AppendCertsFromPEM(b) {
-			return nil, fmt.Errorf("failed to append certificates")
+		config.RootCAs = cp
+	}
+
+	// Set the server name if any.
+	if name != "" {
+		config.ServerName = name
+	}
+
+	return config, nil
+}
+
+// ClientConfigFromPEM is like ClientConfig, but takes the PEM data of
+// the cert, key and ca instead of files, for instance when they come
+// from a secrets manager.
+func ClientConfigFromPEM(cert, key, ca []byte, name string) (*tls.Config, error) {
+	config := &tls.Config{}
+
+	// Parse the client-side cert & key if any.
+	if len(cert) != 0 && len(key) != 0 {
+		crt, err := keyPair(cert, key, "", "")
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{crt}
+	}
+
+	// Parse the server CA if any.
+	if len(ca) != 0 {
+		cp, err := certPool(ca, "")
+		if err != nil {
+			return nil, err
 		}
 		config.RootCAs = cp
 	}
@@ -59,27 +85,24 @@ func ClientConfig(cert, key, ca, name string) (*tls.Config, error) {
 }
 
 // ServerConfig returns the TLS config to use for a server to
-// accept client connections.
+// accept client connections. Failures to load the files are returned
+// as a *ConfigError.
 func ServerConfig(cert, key, ca string) (*tls.Config, error) {
 	config := &tls.Config{}
 
 	// Load the server cert and key.
-	crt, err := tls.LoadX509KeyPair(cert, key)
+	crt, err := loadKeyPair(cert, key)
 	if err != nil {
-		return nil, fmt.Errorf("failed to load cert/key: %v", err)
+		return nil, err
 	}
 	config.Certificates = []tls.Certificate{crt}
 
 	// if specified, load ca to validate client,
 	// and enforce clients present valid certs.
 	if ca != "" {
-		b, err := ioutil.ReadFile(ca)
+		cp, err := loadCertPool(ca)
 		if err != nil {
-			return nil, fmt.Errorf("Failed to read ca file: %v", err)
-		}
-		cp := x509.NewCertPool()
-		if !cp.AppendCertsFromPEM(b) {
-			return nil, fmt.Errorf("Failed to append certificates")
+			return nil, err
 		}
 		config.ClientCAs = cp
 		config.ClientAuth = tls.RequireAndVerifyClientCert